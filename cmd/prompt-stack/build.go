@@ -11,6 +11,11 @@ var buildCmd = &cobra.Command{
 	Short: "Build project from implementation plan",
 	Long:  `Build project components based on implementation plan tasks.`,
 	Run: func(cmd *cobra.Command, args []string) {
+		if jsonOutput {
+			writeJSON(cmd.OutOrStdout(), 0, nil, []string{"build command is not implemented yet"}, nil)
+			return
+		}
+
 		fmt.Println("build command: Build project from implementation plan")
 		_ = cmd.Help()
 	},
@@ -12,6 +12,12 @@ import (
 	"github.com/spf13/cobra"
 )
 
+// Files written by the init interview, relative to --output-dir
+const (
+	interviewTranscriptFile = "requirements-interview.md"
+	requirementsFile        = "requirements.md"
+)
+
 var (
 	interactiveOutputDir string
 	noInteractive        bool
@@ -24,38 +30,44 @@ var initCmd = &cobra.Command{
 	RunE: func(cmd *cobra.Command, args []string) error {
 		ctx := context.Background()
 
-		fmt.Println("=== Prompt Stack Initialization ===")
+		fmt.Fprintln(statusOut(), "=== Prompt Stack Initialization ===")
 
 		configPath := ".prompt-stack/config.yaml"
 		if _, err := os.Stat(configPath); os.IsNotExist(err) {
-			fmt.Printf("Creating config file at %s\n", configPath)
+			fmt.Fprintf(statusOut(), "Creating config file at %s\n", configPath)
 			if err := config.Init(configPath); err != nil {
 				return fmt.Errorf("failed to initialize config: %w", err)
 			}
-			fmt.Printf("✓ Created %s\n", configPath)
+			fmt.Fprintf(statusOut(), "✓ Created %s\n", configPath)
 		} else {
-			fmt.Printf("✓ Config already exists at %s\n", configPath)
+			fmt.Fprintf(statusOut(), "✓ Config already exists at %s\n", configPath)
 		}
 
 		dbPath := ".prompt-stack/knowledge.db"
 		if _, err := os.Stat(dbPath); os.IsNotExist(err) {
-			fmt.Printf("Creating database at %s\n", dbPath)
+			fmt.Fprintf(statusOut(), "Creating database at %s\n", dbPath)
 			if err := database.Init(dbPath); err != nil {
 				return fmt.Errorf("failed to initialize database: %w", err)
 			}
-			fmt.Printf("✓ Created %s\n", dbPath)
+			fmt.Fprintf(statusOut(), "✓ Created %s\n", dbPath)
 		} else {
-			fmt.Printf("✓ Database already exists at %s\n", dbPath)
+			fmt.Fprintf(statusOut(), "✓ Database already exists at %s\n", dbPath)
+		}
+
+		data := map[string]string{
+			"config":   configPath,
+			"database": dbPath,
 		}
 
 		if !noInteractive {
-			fmt.Println("\n=== Requirements Gathering Interview ===")
-			fmt.Println("This will ask you a series of questions to define your milestone requirements.")
-			fmt.Println("Press Ctrl+C to cancel at any time.")
-			fmt.Println()
+			fmt.Fprintln(statusOut(), "\n=== Requirements Gathering Interview ===")
+			fmt.Fprintln(statusOut(), "This will ask you a series of questions to define your milestone requirements.")
+			fmt.Fprintln(statusOut(), "Press Ctrl+C to cancel at any time.")
+			fmt.Fprintln(statusOut())
 
 			questions := prompt.DefaultQuestions()
 			p := prompt.NewPrompt(questions)
+			p.SetOutput(statusOut())
 
 			result, err := p.Run(ctx)
 			if err != nil {
@@ -65,8 +77,13 @@ var initCmd = &cobra.Command{
 			if err := saveInterviewResult(result, interactiveOutputDir); err != nil {
 				return fmt.Errorf("failed to save interview results: %w", err)
 			}
+			data["transcript"] = filepath.Join(interactiveOutputDir, interviewTranscriptFile)
+			data["requirements"] = filepath.Join(interactiveOutputDir, requirementsFile)
 		}
 
+		if jsonOutput {
+			writeJSON(cmd.OutOrStdout(), 0, data, nil, nil)
+		}
 		return nil
 	},
 }
@@ -84,22 +101,22 @@ func saveInterviewResult(result *prompt.InterviewResult, outputDir string) error
 		return fmt.Errorf("failed to create output directory: %w", err)
 	}
 
-	transcriptPath := filepath.Join(outputDir, "requirements-interview.md")
+	transcriptPath := filepath.Join(outputDir, interviewTranscriptFile)
 	if err := os.WriteFile(transcriptPath, []byte(result.Transcript), 0644); err != nil {
 		return fmt.Errorf("failed to write transcript: %w", err)
 	}
-	fmt.Printf("✓ Saved transcript to %s\n", transcriptPath)
+	fmt.Fprintf(statusOut(), "✓ Saved transcript to %s\n", transcriptPath)
 
-	yamlPath := filepath.Join(outputDir, "requirements.md")
+	yamlPath := filepath.Join(outputDir, requirementsFile)
 	yamlContent := generateYAML(result)
 	if err := os.WriteFile(yamlPath, []byte(yamlContent), 0644); err != nil {
 		return fmt.Errorf("failed to write YAML: %w", err)
 	}
-	fmt.Printf("✓ Saved requirements to %s\n", yamlPath)
+	fmt.Fprintf(statusOut(), "✓ Saved requirements to %s\n", yamlPath)
 
-	fmt.Println("\n✓ Requirements gathering complete!")
-	fmt.Printf("  Transcript: %s\n", transcriptPath)
-	fmt.Printf("  Requirements: %s\n", yamlPath)
+	fmt.Fprintln(statusOut(), "\n✓ Requirements gathering complete!")
+	fmt.Fprintf(statusOut(), "  Transcript: %s\n", transcriptPath)
+	fmt.Fprintf(statusOut(), "  Requirements: %s\n", yamlPath)
 
 	return nil
 }
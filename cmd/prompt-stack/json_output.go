@@ -0,0 +1,96 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+// jsonOutput is set by the persistent --json flag. Commands then write a single
+// jsonEnvelope to stdout instead of human-readable text.
+var jsonOutput bool
+
+// Envelope status values
+const (
+	statusOK     = "ok"     // Command succeeded
+	statusFailed = "failed" // Command ran but its checks failed
+	statusError  = "error"  // Command could not run (bad arguments, I/O errors, ...)
+)
+
+// jsonEnvelope is the stable machine-readable shape shared by every command.
+// Code always matches the process exit code.
+type jsonEnvelope struct {
+	Status   string      `json:"status"`
+	Code     int         `json:"code"`
+	Data     interface{} `json:"data"`
+	Warnings []string    `json:"warnings"`
+	Error    string      `json:"error,omitempty"`
+}
+
+func newEnvelope(code int, data interface{}, warnings []string, err error) jsonEnvelope {
+	env := jsonEnvelope{
+		Status:   statusOK,
+		Code:     code,
+		Data:     data,
+		Warnings: warnings,
+	}
+	if env.Warnings == nil {
+		env.Warnings = []string{}
+	}
+
+	switch {
+	case err != nil:
+		env.Status = statusError
+		env.Error = err.Error()
+	case code != 0:
+		env.Status = statusFailed
+	}
+
+	return env
+}
+
+// writeJSON writes the envelope for a command outcome to w.
+func writeJSON(w io.Writer, code int, data interface{}, warnings []string, err error) {
+	out, merr := json.MarshalIndent(newEnvelope(code, data, warnings, err), "", "  ")
+	if merr != nil {
+		out, _ = json.MarshalIndent(newEnvelope(2, nil, nil, fmt.Errorf("failed to marshal result: %w", merr)), "", "  ")
+	}
+	fmt.Fprintln(w, string(out))
+}
+
+// exitJSON writes the envelope to the command's stdout and exits with code.
+func exitJSON(cmd *cobra.Command, code int, data interface{}, warnings []string, err error) {
+	writeJSON(cmd.OutOrStdout(), code, data, warnings, err)
+	osExit(code)
+}
+
+// statusOut is where progress messages go. Under --json they move to stderr so
+// stdout carries only the envelope.
+func statusOut() io.Writer {
+	if jsonOutput {
+		return os.Stderr
+	}
+	return os.Stdout
+}
+
+// jsonRequested reports whether args enable --json, scanning them the way
+// cobra would but without depending on the rest of the command line parsing.
+func jsonRequested(args []string) bool {
+	requested := false
+	for _, arg := range args {
+		if arg == "--" {
+			break
+		}
+		if arg == "--json" {
+			requested = true
+		} else if value, ok := strings.CutPrefix(arg, "--json="); ok {
+			requested, _ = strconv.ParseBool(value)
+		}
+	}
+	return requested
+}
@@ -0,0 +1,155 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestNewEnvelope(t *testing.T) {
+	tests := []struct {
+		name       string
+		code       int
+		err        error
+		wantStatus string
+		wantError  string
+	}{
+		{name: "success", code: 0, wantStatus: statusOK},
+		{name: "failed checks", code: 1, wantStatus: statusFailed},
+		{name: "execution error", code: 2, err: errors.New("file not found"), wantStatus: statusError, wantError: "file not found"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env := newEnvelope(tt.code, nil, nil, tt.err)
+			if env.Status != tt.wantStatus {
+				t.Errorf("Status = %q, want %q", env.Status, tt.wantStatus)
+			}
+			if env.Code != tt.code {
+				t.Errorf("Code = %d, want %d", env.Code, tt.code)
+			}
+			if env.Error != tt.wantError {
+				t.Errorf("Error = %q, want %q", env.Error, tt.wantError)
+			}
+			if env.Warnings == nil {
+				t.Error("Warnings should be an empty slice, not nil")
+			}
+		})
+	}
+}
+
+func TestJSONOutputFlag(t *testing.T) {
+	yamlPath := filepath.Join(t.TempDir(), "ralphy.yaml")
+	content := `name: demo
+tasks:
+  - id: "task-001"
+    title: "Add greeter"
+`
+	if err := os.WriteFile(yamlPath, []byte(content), 0644); err != nil {
+		t.Fatalf("failed to write YAML: %v", err)
+	}
+
+	tests := []struct {
+		name         string
+		args         []string
+		wantStatus   string
+		wantCode     int
+		wantWarnings int
+		wantData     bool
+	}{
+		{
+			name:         "placeholder command",
+			args:         []string{"plan", "--json"},
+			wantStatus:   statusOK,
+			wantWarnings: 1,
+		},
+		{
+			name:       "render task",
+			args:       []string{"ralphy", "render-task", "--json", "--file", yamlPath, "--task", "task-001"},
+			wantStatus: statusOK,
+			wantData:   true,
+		},
+		{
+			name:       "schema violations",
+			args:       []string{"validate-yaml", "--json", "--schema", "../../docs/ralphy-inputs.schema.json", "--file", yamlPath},
+			wantStatus: statusFailed,
+			wantCode:   1,
+			wantData:   true,
+		},
+		{
+			name:       "missing required flag",
+			args:       []string{"validate-constraints", "--json", "--file", ""},
+			wantStatus: statusError,
+			wantCode:   2,
+		},
+		{
+			name:       "unknown command",
+			args:       []string{"--json", "bogus"},
+			wantStatus: statusError,
+			wantCode:   1,
+		},
+		{
+			name:       "unknown flag before --json",
+			args:       []string{"validate", "--bad-flag", "--json"},
+			wantStatus: statusError,
+			wantCode:   1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			buf := new(bytes.Buffer)
+			rootCmd.SetOut(buf)
+			rootCmd.SetErr(new(bytes.Buffer))
+			t.Cleanup(func() {
+				rootCmd.SetOut(nil)
+				rootCmd.SetErr(nil)
+			})
+
+			exitCode := runRoot(t, tt.args)
+
+			var env jsonEnvelope
+			if err := json.Unmarshal(buf.Bytes(), &env); err != nil {
+				t.Fatalf("stdout is not a JSON envelope: %v\nOutput: %s", err, buf.String())
+			}
+			if env.Status != tt.wantStatus {
+				t.Errorf("status = %q, want %q", env.Status, tt.wantStatus)
+			}
+			if env.Code != tt.wantCode || exitCode != tt.wantCode {
+				t.Errorf("code = %d, exit code = %d, want %d", env.Code, exitCode, tt.wantCode)
+			}
+			if len(env.Warnings) != tt.wantWarnings {
+				t.Errorf("warnings = %v, want %d", env.Warnings, tt.wantWarnings)
+			}
+			if (env.Data != nil) != tt.wantData {
+				t.Errorf("data = %v, want present = %v", env.Data, tt.wantData)
+			}
+		})
+	}
+}
+
+func TestJSONRequested(t *testing.T) {
+	tests := []struct {
+		name string
+		args []string
+		want bool
+	}{
+		{name: "absent", args: []string{"validate", "--input", "x"}, want: false},
+		{name: "before command", args: []string{"--json", "bogus"}, want: true},
+		{name: "after unknown flag", args: []string{"validate", "--bad-flag", "--json"}, want: true},
+		{name: "explicit value", args: []string{"plan", "--json=true"}, want: true},
+		{name: "disabled", args: []string{"plan", "--json", "--json=false"}, want: false},
+		{name: "after terminator", args: []string{"plan", "--", "--json"}, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := jsonRequested(tt.args); got != tt.want {
+				t.Errorf("jsonRequested(%v) = %v, want %v", tt.args, got, tt.want)
+			}
+		})
+	}
+}
@@ -15,6 +15,20 @@ var rootCmd = &cobra.Command{
 	Short: "AI-assisted development workflow tool",
 	Long:  `A tool for generating and validating Ralphy YAML files with Plan/Build modes.`,
 	Run: func(cmd *cobra.Command, args []string) {
+		if jsonOutput {
+			commands := []string{}
+			for _, c := range cmd.Commands() {
+				if c.IsAvailableCommand() {
+					commands = append(commands, c.Name())
+				}
+			}
+			writeJSON(cmd.OutOrStdout(), 0, map[string]interface{}{
+				"version":  cmd.Version,
+				"commands": commands,
+			}, nil, nil)
+			return
+		}
+
 		fmt.Println("AI-assisted development workflow tool")
 		_ = cmd.Help()
 	},
@@ -22,10 +36,28 @@ var rootCmd = &cobra.Command{
 
 func init() {
 	rootCmd.Version = fmt.Sprintf("%s (commit: %s, built: %s)", Version, Commit, Date)
+	rootCmd.PersistentFlags().BoolVar(&jsonOutput, "json", false, "Write a machine-readable JSON envelope (status, code, data, warnings) to stdout")
 }
 
 func main() {
+	execute(os.Args[1:])
+}
+
+// execute runs the root command with args. --json is detected before cobra
+// parses flags so unknown commands and flag errors still produce an envelope.
+func execute(args []string) {
+	jsonOutput = jsonRequested(args)
+	// The JSON envelope replaces cobra's own error and usage output.
+	rootCmd.SilenceErrors = jsonOutput
+	rootCmd.SilenceUsage = jsonOutput
+	rootCmd.SetArgs(args)
+
 	if err := rootCmd.Execute(); err != nil {
+		if jsonOutput {
+			writeJSON(rootCmd.OutOrStdout(), 1, nil, nil, err)
+			osExit(1)
+			return
+		}
 		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
 		osExit(1)
 	}
@@ -198,3 +198,18 @@ tasks:
 		})
 	}
 }
+
+// runRoot runs args through execute, as main does, and returns the exit code it reported.
+func runRoot(t *testing.T, args []string) int {
+	t.Helper()
+
+	exitCode := 0
+	osExit = func(code int) { exitCode = code }
+	t.Cleanup(func() {
+		jsonOutput = false
+		osExit = func(code int) { os.Exit(code) }
+	})
+
+	execute(args)
+	return exitCode
+}
@@ -11,6 +11,11 @@ var planCmd = &cobra.Command{
 	Short: "Generate implementation plans",
 	Long:  `Generate implementation plans from requirements or templates.`,
 	Run: func(cmd *cobra.Command, args []string) {
+		if jsonOutput {
+			writeJSON(cmd.OutOrStdout(), 0, nil, []string{"plan command is not implemented yet"}, nil)
+			return
+		}
+
 		fmt.Println("plan command: Generate implementation plans")
 		_ = cmd.Help()
 	},
@@ -3,12 +3,24 @@ package main
 import (
 	"fmt"
 	"os"
+	"strings"
 
 	"github.com/kyledavis/prompt-stack/internal/executor"
 	"github.com/spf13/cobra"
 )
 
-var ralphyDryRun bool
+// Files generated by a dry run
+const (
+	ralphyReportPath   = ".prompt-stack/report.txt"
+	ralphyAuditLogPath = ".prompt-stack/audit.log"
+)
+
+var (
+	ralphyDryRun       bool
+	renderTaskFile     string
+	renderTaskID       string
+	renderTaskTemplate string
+)
 
 var ralphyCmd = &cobra.Command{
 	Use:   "ralphy",
@@ -16,12 +28,39 @@ var ralphyCmd = &cobra.Command{
 	Long:  `Execute Ralphy shell script for AI-assisted task execution. Use --dry-run to generate reports without executing.`,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		if ralphyDryRun {
-			return runRalphyDryRun()
+			return runRalphyDryRun(cmd)
 		}
 		return runRalphyLive()
 	},
 }
 
+var ralphyRenderTaskCmd = &cobra.Command{
+	Use:   "render-task",
+	Short: "Render the agent prompt for a single Ralphy task",
+	Long:  `Render the exact agent prompt for one task in a Ralphy YAML file (description, constraints, files_in_scope, verification expectations). Use --template to supply a custom text/template.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if renderTaskID == "" {
+			return fmt.Errorf("--task is required")
+		}
+
+		rendered, err := executor.RenderTaskPrompt(renderTaskFile, renderTaskID, renderTaskTemplate)
+		if err != nil {
+			return fmt.Errorf("failed to render task prompt: %w", err)
+		}
+
+		if jsonOutput {
+			writeJSON(cmd.OutOrStdout(), 0, map[string]string{
+				"task":   renderTaskID,
+				"prompt": rendered,
+			}, nil, nil)
+			return nil
+		}
+
+		fmt.Fprint(cmd.OutOrStdout(), rendered)
+		return nil
+	},
+}
+
 func runRalphyLive() error {
 	return fmt.Errorf("live execution not implemented yet, use --dry-run flag")
 }
@@ -29,11 +68,17 @@ func runRalphyLive() error {
 func init() {
 	rootCmd.AddCommand(ralphyCmd)
 	ralphyCmd.Flags().BoolVar(&ralphyDryRun, "dry-run", false, "Generate reports without executing")
+
+	ralphyCmd.AddCommand(ralphyRenderTaskCmd)
+	ralphyRenderTaskCmd.Flags().StringVar(&renderTaskFile, "file", "final_ralphy_inputs.yaml", "Path to Ralphy YAML file")
+	ralphyRenderTaskCmd.Flags().StringVar(&renderTaskID, "task", "", "ID of the task to render (required)")
+	ralphyRenderTaskCmd.Flags().StringVar(&renderTaskTemplate, "template", "", "Path to a custom text/template for the prompt (optional)")
 }
 
-func runRalphyDryRun() error {
-	fmt.Println("=== Ralphy Dry-Run Mode ===")
-	fmt.Println()
+func runRalphyDryRun(cmd *cobra.Command) error {
+	out := statusOut()
+	fmt.Fprintln(out, "=== Ralphy Dry-Run Mode ===")
+	fmt.Fprintln(out)
 
 	execr := executor.NewExecutor(".", true)
 
@@ -50,27 +95,40 @@ func runRalphyDryRun() error {
 	}
 
 	if !result.Success {
-		fmt.Println("Dry-run validation failed:")
-		fmt.Println(result.Stderr)
+		fmt.Fprintln(out, "Dry-run validation failed:")
+		fmt.Fprintln(out, result.Stderr)
 		return fmt.Errorf("dry-run validation failed")
 	}
 
-	fmt.Println("✓ Dry-run completed successfully")
-	fmt.Println()
-	fmt.Println("Generated files:")
-	fmt.Printf("  - .prompt-stack/report.txt\n")
-	fmt.Printf("  - .prompt-stack/audit.log\n")
-	fmt.Println()
+	fmt.Fprintln(out, "✓ Dry-run completed successfully")
+	fmt.Fprintln(out)
+	fmt.Fprintln(out, "Generated files:")
+	fmt.Fprintf(out, "  - %s\n", ralphyReportPath)
+	fmt.Fprintf(out, "  - %s\n", ralphyAuditLogPath)
+	fmt.Fprintln(out)
 
-	if _, err := os.Stat(".prompt-stack/report.txt"); err == nil {
-		fmt.Println("Report preview (first 200 chars):")
-		content, _ := os.ReadFile(".prompt-stack/report.txt")
+	if _, err := os.Stat(ralphyReportPath); err == nil {
+		fmt.Fprintln(out, "Report preview (first 200 chars):")
+		content, _ := os.ReadFile(ralphyReportPath)
 		if len(content) > 200 {
-			fmt.Println(string(content[:200]) + "...")
+			fmt.Fprintln(out, string(content[:200])+"...")
 		} else {
-			fmt.Println(string(content))
+			fmt.Fprintln(out, string(content))
+		}
+		fmt.Fprintln(out)
+	}
+
+	if jsonOutput {
+		var warnings []string
+		for _, line := range strings.Split(result.Stderr, "\n") {
+			if line = strings.TrimSpace(line); line != "" {
+				warnings = append(warnings, line)
+			}
 		}
-		fmt.Println()
+		writeJSON(cmd.OutOrStdout(), 0, map[string]string{
+			"report":    ralphyReportPath,
+			"audit_log": ralphyAuditLogPath,
+		}, warnings, nil)
 	}
 
 	return nil
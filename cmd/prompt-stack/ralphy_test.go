@@ -89,13 +89,11 @@ func TestRalphyDryRunCreatesReportFiles(t *testing.T) {
 		t.Fatalf("failed to create vendor directory: %v", err)
 	}
 
-	rootCmd.SetArgs([]string{"ralphy", "--dry-run"})
 	rootCmd.SetOut(nil)
 	rootCmd.SetErr(nil)
 
-	err = rootCmd.Execute()
-	if err != nil {
-		t.Fatalf("ralphy dry-run failed: %v", err)
+	if code := runRoot(t, []string{"ralphy", "--dry-run"}); code != 0 {
+		t.Fatalf("ralphy dry-run exited with code %d", code)
 	}
 
 	reportPath := filepath.Join(tmpDir, ".prompt-stack", "report.txt")
@@ -148,12 +146,74 @@ func TestRalphyCommandIntegration(t *testing.T) {
 		}
 
 		buf := new(bytes.Buffer)
-		rootCmd.SetArgs([]string{"ralphy", "--help"})
 		rootCmd.SetOut(buf)
 		rootCmd.SetErr(buf)
-		err := rootCmd.Execute()
-		if err != nil {
-			t.Errorf("ralphy --help failed: %v", err)
+		t.Cleanup(func() {
+			rootCmd.SetOut(nil)
+			rootCmd.SetErr(nil)
+		})
+
+		if code := runRoot(t, []string{"ralphy", "--help"}); code != 0 {
+			t.Errorf("ralphy --help exited with code %d", code)
 		}
 	})
 }
+
+func TestRalphyRenderTask(t *testing.T) {
+	yamlPath := filepath.Join(t.TempDir(), "ralphy.yaml")
+	content := `name: demo
+tasks:
+  - id: "task-001"
+    title: "Add greeter"
+    description: "Implement the greeter package."
+    files_in_scope:
+      - "pkg/greeter/greeter.go"
+`
+	if err := os.WriteFile(yamlPath, []byte(content), 0644); err != nil {
+		t.Fatalf("failed to write YAML: %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		args     []string
+		wantErr  bool
+		contains string
+	}{
+		{
+			name:     "renders known task",
+			args:     []string{"ralphy", "render-task", "--file", yamlPath, "--task", "task-001"},
+			contains: "# Task task-001: Add greeter",
+		},
+		{
+			name:    "unknown task",
+			args:    []string{"ralphy", "render-task", "--file", yamlPath, "--task", "task-404"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			buf := new(bytes.Buffer)
+			rootCmd.SetOut(buf)
+			rootCmd.SetErr(buf)
+			t.Cleanup(func() {
+				rootCmd.SetOut(nil)
+				rootCmd.SetErr(nil)
+			})
+
+			code := runRoot(t, tt.args)
+			if tt.wantErr {
+				if code == 0 {
+					t.Error("expected non-zero exit code but got 0")
+				}
+				return
+			}
+			if code != 0 {
+				t.Fatalf("render-task exited with code %d\nOutput: %s", code, buf.String())
+			}
+			if !bytes.Contains(buf.Bytes(), []byte(tt.contains)) {
+				t.Errorf("output missing %q\nOutput: %s", tt.contains, buf.String())
+			}
+		})
+	}
+}
@@ -11,6 +11,12 @@ import (
 	"github.com/spf13/cobra"
 )
 
+// Files written by the requirements interview
+const (
+	planningTranscriptPath = ".prompt-stack/requirements-transcript.txt"
+	planningInputFile      = "planning-input.yaml"
+)
+
 var (
 	requirementsOutput string
 )
@@ -22,13 +28,14 @@ var requirementsCmd = &cobra.Command{
 	RunE: func(cmd *cobra.Command, args []string) error {
 		ctx := context.Background()
 
-		fmt.Println("=== Planning Input Requirements Gathering ===")
-		fmt.Println("This will ask you a series of questions to define planning input for the Plan Mode.")
-		fmt.Println("Press Ctrl+C to cancel at any time.")
-		fmt.Println()
+		fmt.Fprintln(statusOut(), "=== Planning Input Requirements Gathering ===")
+		fmt.Fprintln(statusOut(), "This will ask you a series of questions to define planning input for the Plan Mode.")
+		fmt.Fprintln(statusOut(), "Press Ctrl+C to cancel at any time.")
+		fmt.Fprintln(statusOut())
 
 		questions := PlanningQuestions()
 		p := prompt.NewPrompt(questions)
+		p.SetOutput(statusOut())
 
 		result, err := p.Run(ctx)
 		if err != nil {
@@ -39,6 +46,12 @@ var requirementsCmd = &cobra.Command{
 			return fmt.Errorf("failed to save planning results: %w", err)
 		}
 
+		if jsonOutput {
+			writeJSON(cmd.OutOrStdout(), 0, map[string]string{
+				"transcript":     planningTranscriptPath,
+				"planning_input": filepath.Join(requirementsOutput, planningInputFile),
+			}, nil, nil)
+		}
 		return nil
 	},
 }
@@ -301,25 +314,25 @@ func savePlanningResult(result *prompt.InterviewResult, outputDir string) error
 		return fmt.Errorf("failed to create output directory: %w", err)
 	}
 
-	transcriptPath := ".prompt-stack/requirements-transcript.txt"
+	transcriptPath := planningTranscriptPath
 	if err := os.MkdirAll(filepath.Dir(transcriptPath), 0755); err != nil {
 		return fmt.Errorf("failed to create transcript directory: %w", err)
 	}
 	if err := os.WriteFile(transcriptPath, []byte(result.Transcript), 0644); err != nil {
 		return fmt.Errorf("failed to write transcript: %w", err)
 	}
-	fmt.Printf("✓ Saved transcript to %s\n", transcriptPath)
+	fmt.Fprintf(statusOut(), "✓ Saved transcript to %s\n", transcriptPath)
 
-	yamlPath := filepath.Join(outputDir, "planning-input.yaml")
+	yamlPath := filepath.Join(outputDir, planningInputFile)
 	yamlContent := generatePlanningYAML(result)
 	if err := os.WriteFile(yamlPath, []byte(yamlContent), 0644); err != nil {
 		return fmt.Errorf("failed to write planning YAML: %w", err)
 	}
-	fmt.Printf("✓ Saved planning input to %s\n", yamlPath)
+	fmt.Fprintf(statusOut(), "✓ Saved planning input to %s\n", yamlPath)
 
-	fmt.Println("\n✓ Planning input generation complete!")
-	fmt.Printf("  Transcript: %s\n", transcriptPath)
-	fmt.Printf("  Planning input: %s\n", yamlPath)
+	fmt.Fprintln(statusOut(), "\n✓ Planning input generation complete!")
+	fmt.Fprintf(statusOut(), "  Transcript: %s\n", transcriptPath)
+	fmt.Fprintf(statusOut(), "  Planning input: %s\n", yamlPath)
 
 	return nil
 }
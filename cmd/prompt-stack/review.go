@@ -11,6 +11,11 @@ var reviewCmd = &cobra.Command{
 	Short: "Review implementation progress",
 	Long:  `Review implementation progress and quality metrics.`,
 	Run: func(cmd *cobra.Command, args []string) {
+		if jsonOutput {
+			writeJSON(cmd.OutOrStdout(), 0, nil, []string{"review command is not implemented yet"}, nil)
+			return
+		}
+
 		fmt.Println("review command: Review implementation progress")
 		_ = cmd.Help()
 	},
@@ -17,12 +17,24 @@ var scanSecretsCmd = &cobra.Command{
 		outputPath, _ := cmd.Flags().GetString("output")
 
 		if filePath == "" {
+			if jsonOutput {
+				exitJSON(cmd, 2, nil, nil, fmt.Errorf("--file is required"))
+				return
+			}
 			fmt.Fprintln(os.Stderr, "Error: --file is required")
 			_ = cmd.Help()
 			os.Exit(2)
 		}
 
 		exitCode, report, err := security.ScanSecrets(filePath, outputPath)
+		if jsonOutput {
+			if err != nil {
+				exitJSON(cmd, exitCode, nil, nil, err)
+				return
+			}
+			exitJSON(cmd, exitCode, report, nil, nil)
+			return
+		}
 		if err != nil {
 			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
 			os.Exit(exitCode)
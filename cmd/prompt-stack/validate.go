@@ -20,6 +20,10 @@ var validateCmd = &cobra.Command{
 	Long:  `Validate implementation plans against schema and quality standards.`,
 	Run: func(cmd *cobra.Command, args []string) {
 		if validateInput == "" {
+			if jsonOutput {
+				exitJSON(cmd, 1, nil, nil, fmt.Errorf("--input is required"))
+				return
+			}
 			fmt.Println("Error: --input is required")
 			_ = cmd.Help()
 			os.Exit(1)
@@ -32,6 +36,14 @@ var validateCmd = &cobra.Command{
 		}
 
 		result, err := validation.Validate(config)
+		if jsonOutput {
+			code := 0
+			if err != nil || result.OverallResult == "FAIL" {
+				code = 1
+			}
+			exitJSON(cmd, code, result, nil, err)
+			return
+		}
 		if err != nil {
 			fmt.Printf("Validation error: %v\n", err)
 			os.Exit(1)
@@ -0,0 +1,89 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/kyledavis/prompt-stack/internal/validation/enforcement"
+	"github.com/spf13/cobra"
+)
+
+var validateBadgeCmd = &cobra.Command{
+	Use:   "badge",
+	Short: "Generate an SVG enforcement badge",
+	Long:  `Generates an SVG badge showing the number of enforcement layers and the pass/fail status of a Ralphy YAML file. The badge is rendered locally and can be embedded in a repository README.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		yamlPath, _ := cmd.Flags().GetString("file")
+		outPath, _ := cmd.Flags().GetString("out")
+
+		if yamlPath == "" || outPath == "" {
+			if jsonOutput {
+				exitJSON(cmd, 2, nil, nil, fmt.Errorf("both --file and --out are required"))
+				return
+			}
+			fmt.Fprintln(os.Stderr, "Error: both --file and --out are required")
+			_ = cmd.Help()
+			os.Exit(2)
+		}
+
+		_, result, err := enforcement.ValidateEnforcementFromFile(yamlPath)
+		if err != nil {
+			if jsonOutput {
+				exitJSON(cmd, 2, nil, nil, err)
+				return
+			}
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(2)
+		}
+
+		badge, err := enforcement.GenerateBadge(result)
+		if err != nil {
+			if jsonOutput {
+				exitJSON(cmd, 2, nil, nil, err)
+				return
+			}
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(2)
+		}
+
+		if err := os.MkdirAll(filepath.Dir(outPath), 0755); err != nil {
+			if jsonOutput {
+				exitJSON(cmd, 2, nil, nil, fmt.Errorf("failed to create output directory: %w", err))
+				return
+			}
+			fmt.Fprintf(os.Stderr, "Failed to create output directory: %v\n", err)
+			os.Exit(2)
+		}
+
+		if err := os.WriteFile(outPath, []byte(badge), 0644); err != nil {
+			if jsonOutput {
+				exitJSON(cmd, 2, nil, nil, fmt.Errorf("failed to write badge: %w", err))
+				return
+			}
+			fmt.Fprintf(os.Stderr, "Failed to write badge: %v\n", err)
+			os.Exit(2)
+		}
+
+		status := "pass"
+		if !result.Valid {
+			status = "fail"
+		}
+		if jsonOutput {
+			writeJSON(cmd.OutOrStdout(), 0, map[string]interface{}{
+				"file":   yamlPath,
+				"out":    outPath,
+				"layers": result.VerificationLayers.TotalLayers,
+				"status": status,
+			}, nil, nil)
+			return
+		}
+		fmt.Printf("Badge written to %s (%d layers, %s)\n", outPath, result.VerificationLayers.TotalLayers, status)
+	},
+}
+
+func init() {
+	validateCmd.AddCommand(validateBadgeCmd)
+	validateBadgeCmd.Flags().String("file", "final_ralphy_inputs.yaml", "Path to YAML file to validate")
+	validateBadgeCmd.Flags().String("out", "badge.svg", "Path to write the SVG badge")
+}
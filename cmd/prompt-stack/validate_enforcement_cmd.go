@@ -17,12 +17,20 @@ var validateEnforcementCmd = &cobra.Command{
 		yamlPath, _ := cmd.Flags().GetString("file")
 
 		if yamlPath == "" {
+			if jsonOutput {
+				exitJSON(cmd, 2, nil, nil, fmt.Errorf("--file is required"))
+				return
+			}
 			fmt.Fprintln(os.Stderr, "Error: --file is required")
 			_ = cmd.Help()
 			os.Exit(2)
 		}
 
 		exitCode, result, err := enforcement.ValidateEnforcementFromFile(yamlPath)
+		if jsonOutput {
+			exitJSON(cmd, exitCode, result, nil, err)
+			return
+		}
 		if err != nil {
 			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
 			os.Exit(exitCode)
@@ -17,12 +17,24 @@ var validateQualityCmd = &cobra.Command{
 		reportsDir, _ := cmd.Flags().GetString("reports-dir")
 
 		if reportsDir == "" {
+			if jsonOutput {
+				exitJSON(cmd, 2, nil, nil, fmt.Errorf("--reports-dir is required"))
+				return
+			}
 			fmt.Fprintln(os.Stderr, "Error: --reports-dir is required")
 			_ = cmd.Help()
 			os.Exit(2)
 		}
 
 		report, err := quality.GenerateQualityReport(reportsDir)
+		if jsonOutput {
+			if err != nil {
+				exitJSON(cmd, 2, nil, nil, err)
+				return
+			}
+			exitJSON(cmd, 0, report, nil, nil)
+			return
+		}
 		if err != nil {
 			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
 			os.Exit(2)
@@ -18,27 +18,48 @@ var validateYamlCmd = &cobra.Command{
 		yamlPath, _ := cmd.Flags().GetString("file")
 
 		if schemaPath == "" || yamlPath == "" {
+			if jsonOutput {
+				exitJSON(cmd, 2, nil, nil, fmt.Errorf("both --schema and --file are required"))
+				return
+			}
 			fmt.Fprintln(os.Stderr, "Error: both --schema and --file are required")
 			_ = cmd.Help()
 			os.Exit(2)
 		}
 
 		exitCode, err := validation.ValidateYAML(schemaPath, yamlPath)
-		report := map[string]interface{}{
-			"summary": map[string]interface{}{
-				"validation_type": "yaml_syntax",
-				"status":          "passed",
-				"validator_tool":  "prompt-stack",
-				"file_validated":  yamlPath,
-				"schema_used":     schemaPath,
-				"validation_details": map[string]string{
+		summary := map[string]interface{}{
+			"validation_type": "yaml_syntax",
+			"status":          "passed",
+			"validator_tool":  "prompt-stack",
+			"file_validated":  yamlPath,
+			"schema_used":     schemaPath,
+			"validation_details": map[string]string{
+				"yaml_conversion":   "success",
+				"json_conversion":   "success",
+				"schema_validation": "success",
+			},
+			"exit_code": float64(exitCode),
+			"message":   "Validation passed",
+		}
+		report := map[string]interface{}{"summary": summary}
+		if jsonOutput {
+			if exitCode == validation.ExitExecution {
+				exitJSON(cmd, exitCode, nil, nil, err)
+				return
+			}
+			if exitCode == validation.ExitFailed {
+				summary["status"] = "failed"
+				summary["validation_details"] = map[string]string{
 					"yaml_conversion":   "success",
 					"json_conversion":   "success",
-					"schema_validation": "success",
-				},
-				"exit_code": float64(exitCode),
-				"message":   "Validation passed",
-			},
+					"schema_validation": "failed",
+				}
+				summary["message"] = "Validation failed"
+				report["errors"] = validation.SchemaErrors(err)
+			}
+			exitJSON(cmd, exitCode, report, nil, nil)
+			return
 		}
 		if err != nil {
 			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
@@ -11,7 +11,8 @@
 //
 // Features
 //
-//   - YAML parsing and task extraction
+//   - YAML parsing and task extraction, including anchors/aliases and
+//     `---`-separated multi-document files (each document is validated)
 //   - Task duration validation (min/max bounds)
 //   - Dependency graph analysis for cycles
 //   - Parallel execution opportunity identification
@@ -24,7 +25,7 @@ import (
 	"fmt"
 	"os"
 
-	"gopkg.in/yaml.v3"
+	"github.com/kyledavis/prompt-stack/internal/shared"
 )
 
 // Exit codes for predictable script behavior
@@ -62,6 +63,7 @@ type TaskSizingConfig struct {
 // ValidationResult represents the result of task sizing validation
 type ValidationResult struct {
 	Valid               bool                `json:"valid"`
+	Documents           int                 `json:"documents"`
 	TotalTasks          int                 `json:"total_tasks"`
 	Violations          []Violation         `json:"violations,omitempty"`
 	Summary             Summary             `json:"summary"`
@@ -70,6 +72,7 @@ type ValidationResult struct {
 
 // Violation represents a single task sizing violation
 type Violation struct {
+	Document    int    `json:"document"`
 	TaskID      string `json:"task_id"`
 	Title       string `json:"title"`
 	Issue       string `json:"issue"`
@@ -96,19 +99,86 @@ type ParallelOpportunity struct {
 	ParallelGroups        [][]string `json:"parallel_groups,omitempty"`
 }
 
-// loadYAML reads and parses a YAML file
-func loadYAML(yamlPath string) (*RalphyYAML, error) {
+// loadYAML reads and parses a YAML file, returning one RalphyYAML per `---`-separated
+// document. Anchors, aliases, and merge keys are resolved within each document. A file
+// with no documents yields a single empty RalphyYAML.
+func loadYAML(yamlPath string) ([]*RalphyYAML, error) {
 	yamlBytes, err := os.ReadFile(yamlPath)
 	if err != nil {
 		return nil, fmt.Errorf("failed to read YAML file %q: %w", yamlPath, err)
 	}
 
-	var config RalphyYAML
-	if err := yaml.Unmarshal(yamlBytes, &config); err != nil {
-		return nil, fmt.Errorf("failed to parse YAML: %w", err)
+	documents, err := shared.DecodeYAMLDocuments(yamlBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	configs := []*RalphyYAML{}
+	for index, document := range documents {
+		var config RalphyYAML
+		if err := document.Decode(&config); err != nil {
+			return nil, fmt.Errorf("failed to parse YAML document %d: %w", index+1, err)
+		}
+		configs = append(configs, &config)
+	}
+
+	if len(configs) == 0 {
+		configs = append(configs, &RalphyYAML{})
+	}
+
+	return configs, nil
+}
+
+// validateDocuments validates each document and merges the results, tagging every
+// violation with the number (from 1) of the document it came from.
+func validateDocuments(configs []*RalphyYAML) ValidationResult {
+	merged := ValidationResult{
+		Valid:      true,
+		Documents:  len(configs),
+		Violations: []Violation{},
+		Summary: Summary{
+			MinDuration: 999999,
+			MaxDuration: 0,
+		},
+		ParallelOpportunity: ParallelOpportunity{
+			IndependentTaskIDs: []string{},
+			ParallelGroups:     [][]string{},
+		},
+	}
+
+	totalDuration := 0.0
+	for index, config := range configs {
+		result := validateTaskSizing(config)
+
+		merged.Valid = merged.Valid && result.Valid
+		merged.TotalTasks += result.TotalTasks
+		for _, v := range result.Violations {
+			v.Document = index + 1
+			merged.Violations = append(merged.Violations, v)
+		}
+
+		merged.Summary.TasksWithinRange += result.Summary.TasksWithinRange
+		merged.Summary.TasksOutsideRange += result.Summary.TasksOutsideRange
+		merged.Summary.TasksWithDependencies += result.Summary.TasksWithDependencies
+		merged.Summary.TasksWithoutDependencies += result.Summary.TasksWithoutDependencies
+		if result.Summary.MinDuration < merged.Summary.MinDuration {
+			merged.Summary.MinDuration = result.Summary.MinDuration
+		}
+		if result.Summary.MaxDuration > merged.Summary.MaxDuration {
+			merged.Summary.MaxDuration = result.Summary.MaxDuration
+		}
+		totalDuration += result.Summary.AverageDuration * float64(result.TotalTasks)
+
+		merged.ParallelOpportunity.IndependentTaskIDs = append(merged.ParallelOpportunity.IndependentTaskIDs, result.ParallelOpportunity.IndependentTaskIDs...)
+		merged.ParallelOpportunity.ParallelGroups = append(merged.ParallelOpportunity.ParallelGroups, result.ParallelOpportunity.ParallelGroups...)
+	}
+
+	if merged.TotalTasks > 0 {
+		merged.Summary.AverageDuration = totalDuration / float64(merged.TotalTasks)
 	}
+	merged.ParallelOpportunity.TotalIndependentTasks = len(merged.ParallelOpportunity.IndependentTaskIDs)
 
-	return &config, nil
+	return merged
 }
 
 // validateTaskSizing validates all tasks against sizing guidelines
@@ -232,12 +302,12 @@ func identifyParallelGroups(tasks []Task, dependencyMap map[string][]string) [][
 //	ValidationResult - Validation result containing findings
 //	error - Details about execution error (nil on validation failure)
 func ValidateTaskSizing(yamlPath string) (int, ValidationResult, error) {
-	config, err := loadYAML(yamlPath)
+	configs, err := loadYAML(yamlPath)
 	if err != nil {
 		return ExitExecution, ValidationResult{}, err
 	}
 
-	result := validateTaskSizing(config)
+	result := validateDocuments(configs)
 
 	if !result.Valid {
 		return ExitFailed, result, nil
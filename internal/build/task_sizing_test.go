@@ -0,0 +1,189 @@
+package build
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func writeYAML(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "ralphy.yaml")
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatalf("failed to write YAML: %v", err)
+	}
+	return path
+}
+
+func TestLoadYAML(t *testing.T) {
+	tests := []struct {
+		name      string
+		content   string
+		wantDocs  int
+		wantTasks []int
+		wantErr   string
+	}{
+		{
+			name: "single document",
+			content: `task_sizing: {min_minutes: 30, max_minutes: 150, max_files: 5}
+tasks:
+  - id: t1
+    estimated_duration_minutes: 60
+`,
+			wantDocs:  1,
+			wantTasks: []int{1},
+		},
+		{
+			name: "anchors and merge keys",
+			content: `sizing: &sizing {min_minutes: 30, max_minutes: 150, max_files: 2}
+task_defaults: &defaults
+  estimated_duration_minutes: 45
+  files_in_scope: &files [a.go, b.go]
+task_sizing: *sizing
+tasks:
+  - <<: *defaults
+    id: t1
+  - id: t2
+    estimated_duration_minutes: 90
+    files_in_scope: *files
+`,
+			wantDocs:  1,
+			wantTasks: []int{2},
+		},
+		{
+			name: "multiple documents",
+			content: `tasks:
+  - id: t1
+---
+tasks:
+  - id: t2
+  - id: t3
+`,
+			wantDocs:  2,
+			wantTasks: []int{1, 2},
+		},
+		{
+			name: "trailing separator",
+			content: `tasks:
+  - id: t1
+---
+`,
+			wantDocs:  1,
+			wantTasks: []int{1},
+		},
+		{
+			name: "comment-only document",
+			content: `tasks:
+  - id: t1
+---
+# placeholder for the next milestone
+---
+tasks:
+  - id: t2
+`,
+			wantDocs:  2,
+			wantTasks: []int{1, 1},
+		},
+		{
+			name:      "empty file",
+			content:   "# nothing here\n",
+			wantDocs:  1,
+			wantTasks: []int{0},
+		},
+		{
+			name: "invalid second document",
+			content: `tasks: []
+---
+tasks: [unclosed
+`,
+			wantErr: "failed to parse YAML document 2",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			configs, err := loadYAML(writeYAML(t, tt.content))
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("loadYAML() error = %v, want containing %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("loadYAML() error = %v", err)
+			}
+			if len(configs) != tt.wantDocs {
+				t.Fatalf("documents = %d, want %d", len(configs), tt.wantDocs)
+			}
+			for i, want := range tt.wantTasks {
+				if got := len(configs[i].Tasks); got != want {
+					t.Errorf("document %d tasks = %d, want %d", i, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestLoadYAMLResolvesAnchors(t *testing.T) {
+	configs, err := loadYAML(writeYAML(t, `task_defaults: &defaults
+  estimated_duration_minutes: 45
+  files_in_scope: [a.go, b.go]
+tasks:
+  - <<: *defaults
+    id: t1
+`))
+	if err != nil {
+		t.Fatalf("loadYAML() error = %v", err)
+	}
+
+	task := configs[0].Tasks[0]
+	if task.ID != "t1" || task.EstimatedDurationMinutes != 45 || len(task.FilesInScope) != 2 {
+		t.Errorf("merged task = %+v, want id t1 with 45 minutes and 2 files", task)
+	}
+}
+
+func TestValidateTaskSizingMultiDocument(t *testing.T) {
+	path := writeYAML(t, `task_sizing: {min_minutes: 30, max_minutes: 150, max_files: 5}
+tasks:
+  - id: a1
+    estimated_duration_minutes: 60
+  - id: a2
+    estimated_duration_minutes: 120
+    dependencies: [a1]
+---
+task_sizing: {min_minutes: 30, max_minutes: 150, max_files: 5}
+tasks:
+  - id: b1
+    estimated_duration_minutes: 200
+`)
+
+	exitCode, result, err := ValidateTaskSizing(path)
+	if err != nil {
+		t.Fatalf("ValidateTaskSizing() error = %v", err)
+	}
+	if exitCode != ExitFailed {
+		t.Errorf("exit code = %d, want %d", exitCode, ExitFailed)
+	}
+	if result.Documents != 2 {
+		t.Errorf("Documents = %d, want 2", result.Documents)
+	}
+	if result.TotalTasks != 3 {
+		t.Errorf("TotalTasks = %d, want 3", result.TotalTasks)
+	}
+	if len(result.Violations) != 1 {
+		t.Fatalf("violations = %d, want 1", len(result.Violations))
+	}
+	if v := result.Violations[0]; v.Document != 2 || v.TaskID != "b1" || v.Issue != "duration_above_maximum" {
+		t.Errorf("violation = %+v, want document 2 task b1 duration_above_maximum", v)
+	}
+	if result.Summary.MinDuration != 60 || result.Summary.MaxDuration != 200 {
+		t.Errorf("min/max = %d/%d, want 60/200", result.Summary.MinDuration, result.Summary.MaxDuration)
+	}
+	if got := result.Summary.AverageDuration; got < 126.66 || got > 126.67 {
+		t.Errorf("AverageDuration = %.2f, want 126.67", got)
+	}
+	if result.ParallelOpportunity.TotalIndependentTasks != 2 {
+		t.Errorf("TotalIndependentTasks = %d, want 2", result.ParallelOpportunity.TotalIndependentTasks)
+	}
+}
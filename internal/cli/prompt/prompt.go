@@ -4,6 +4,7 @@ import (
 	"bufio"
 	"context"
 	"fmt"
+	"io"
 	"os"
 	"strings"
 )
@@ -18,6 +19,7 @@ type Question struct {
 type Prompt struct {
 	questions []Question
 	responses map[string]string
+	out       io.Writer
 }
 
 type InterviewResult struct {
@@ -29,9 +31,15 @@ func NewPrompt(questions []Question) *Prompt {
 	return &Prompt{
 		questions: questions,
 		responses: make(map[string]string),
+		out:       os.Stdout,
 	}
 }
 
+// SetOutput sets where questions and validation messages are written (default os.Stdout).
+func (p *Prompt) SetOutput(w io.Writer) {
+	p.out = w
+}
+
 var readStringFunc = func(reader *bufio.Reader, delim byte) (string, error) {
 	return reader.ReadString(delim)
 }
@@ -53,11 +61,11 @@ func (p *Prompt) Run(ctx context.Context) (*InterviewResult, error) {
 		var err error
 
 		for {
-			fmt.Printf("%s\n", q.Text)
+			fmt.Fprintf(p.out, "%s\n", q.Text)
 			if q.Required {
-				fmt.Printf("(Required) Your answer: ")
+				fmt.Fprintf(p.out, "(Required) Your answer: ")
 			} else {
-				fmt.Printf("(Optional, press Enter to skip) Your answer: ")
+				fmt.Fprintf(p.out, "(Optional, press Enter to skip) Your answer: ")
 			}
 
 			response, err = readStringFunc(reader, '\n')
@@ -73,13 +81,13 @@ func (p *Prompt) Run(ctx context.Context) (*InterviewResult, error) {
 
 			if q.Validate != nil {
 				if err := q.Validate(response); err != nil {
-					fmt.Printf("Validation error: %v\n", err)
+					fmt.Fprintf(p.out, "Validation error: %v\n", err)
 					continue
 				}
 			}
 
 			if response == "" && q.Required {
-				fmt.Println("This field is required. Please provide an answer.")
+				fmt.Fprintln(p.out, "This field is required. Please provide an answer.")
 				continue
 			}
 
@@ -2,6 +2,7 @@ package prompt
 
 import (
 	"bufio"
+	"bytes"
 	"context"
 	"fmt"
 	"strings"
@@ -271,3 +272,25 @@ func TestPromptRun_TranscriptFormat(t *testing.T) {
 		t.Error("Transcript missing second question")
 	}
 }
+
+func TestPromptRun_SetOutput(t *testing.T) {
+	p := NewPrompt([]Question{
+		{ID: "q1", Text: "What is your name?", Required: true},
+	})
+	var out bytes.Buffer
+	p.SetOutput(&out)
+
+	oldReadStringFunc := readStringFunc
+	defer func() { readStringFunc = oldReadStringFunc }()
+
+	readStringFunc = func(reader *bufio.Reader, delim byte) (string, error) {
+		return "Jane\n", nil
+	}
+
+	if _, err := p.Run(context.Background()); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !strings.Contains(out.String(), "What is your name?") {
+		t.Errorf("Expected question written to configured output, got %q", out.String())
+	}
+}
@@ -0,0 +1,339 @@
+package executor
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"strings"
+	"text/template"
+
+	"github.com/kyledavis/prompt-stack/internal/shared"
+	"gopkg.in/yaml.v3"
+)
+
+// defaultTaskPromptTemplate renders a single Ralphy task as a self-contained agent prompt,
+// wrapped in the file's prompt_template prefix and suffix.
+const defaultTaskPromptTemplate = `{{with trim .Prefix}}{{.}}
+
+{{end}}# Task {{.Task.ID}}: {{.Task.Title}}
+
+Project: {{.Project}}
+{{- if .RulesFile}}
+Rules file: {{.RulesFile}}
+{{- end}}
+
+## Description
+
+{{.Task.Description}}
+{{- if .Task.SingleResponsibility}}
+
+Single responsibility: {{.Task.SingleResponsibility}}
+{{- end}}
+{{- if .Task.Dependencies}}
+
+Depends on: {{join .Task.Dependencies ", "}}
+{{- end}}
+
+## Files in scope
+
+Only modify these files:
+{{range .Task.FilesInScope}}- {{.}}
+{{else}}- (none declared)
+{{end}}
+{{- if .DisallowedFileEdits}}
+Never modify:
+{{range .DisallowedFileEdits}}- {{.}}
+{{end}}
+{{- end}}
+{{- if or .Task.StyleAnchors .StyleAnchors}}
+## Style anchors
+
+{{range .Task.StyleAnchors}}- {{.File}}{{if .Reason}}: {{.Reason}}{{end}}
+{{end}}
+{{- range .StyleAnchors}}- {{.}}
+{{end}}
+{{- end}}
+{{- if .Constraints}}
+## Constraints
+
+{{range .Constraints}}- {{.}}
+{{end}}
+{{- end}}
+{{- if .Task.AcceptanceCriteria}}
+## Acceptance criteria
+
+{{range .Task.AcceptanceCriteria}}- {{.}}
+{{end}}
+{{- end}}
+## Verification
+
+{{- if .Task.Verification.PreCommit}}
+
+Before committing, run:
+{{range .Task.Verification.PreCommit}}- {{.}}
+{{end}}
+{{- end}}
+{{- if .Task.Verification.PostCommit}}
+
+After committing, run:
+{{range .Task.Verification.PostCommit}}- {{.}}
+{{end}}
+{{- end}}
+{{- if .Task.Verification.Runtime}}
+
+At runtime, confirm:
+{{range .Task.Verification.Runtime}}- {{.}}
+{{end}}
+{{- end}}
+{{- if not (or .Task.Verification.PreCommit .Task.Verification.PostCommit .Task.Verification.Runtime)}}
+
+No verification commands declared; run the project test suite before committing.
+{{end}}
+{{- if .TDDRequired}}
+Write failing tests before the implementation (TDD required).
+{{end}}
+{{- if .TestCommands}}
+Run the test suite with:
+{{range .TestCommands}}- {{.}}
+{{end}}
+{{- end}}
+{{- if .FailureInstruction}}
+If tests fail: {{.FailureInstruction}}
+{{end}}
+{{- with trim .Suffix}}
+{{.}}
+{{end}}`
+
+// RalphyYAML is the subset of a Ralphy YAML file needed to render task prompts.
+type RalphyYAML struct {
+	Name              string            `yaml:"name"`
+	Description       string            `yaml:"description"`
+	RulesFile         string            `yaml:"rules_file"`
+	StyleAnchors      []string          `yaml:"style_anchors,omitempty"`
+	TDD               TDDConfig         `yaml:"tdd,omitempty"`
+	Outputs           OutputsConfig     `yaml:"outputs,omitempty"`
+	PromptTemplate    PromptTemplate    `yaml:"prompt_template,omitempty"`
+	GlobalConstraints GlobalConstraints `yaml:"global_constraints"`
+	Tasks             []RalphyTask      `yaml:"tasks"`
+}
+
+type TDDConfig struct {
+	Required           bool         `yaml:"required"`
+	TestCommand        TestCommands `yaml:"test_command,omitempty"`
+	FailureInstruction string       `yaml:"failure_instruction,omitempty"`
+}
+
+// TestCommands holds tdd.test_command, which the schema allows as a string or a list.
+type TestCommands []string
+
+func (c *TestCommands) UnmarshalYAML(node *yaml.Node) error {
+	if node.Kind == yaml.ScalarNode {
+		var command string
+		if err := node.Decode(&command); err != nil {
+			return err
+		}
+		*c = nil
+		if command != "" {
+			*c = TestCommands{command}
+		}
+		return nil
+	}
+
+	var commands []string
+	if err := node.Decode(&commands); err != nil {
+		return err
+	}
+	*c = commands
+	return nil
+}
+
+type OutputsConfig struct {
+	AllowedFileEdits    []string `yaml:"allowed_file_edits,omitempty"`
+	DisallowedFileEdits []string `yaml:"disallowed_file_edits,omitempty"`
+}
+
+// PromptTemplate wraps every model prompt. Prefix and suffix may contain the
+// {{rules_file}} and {{style_anchors}} placeholders.
+type PromptTemplate struct {
+	Prefix string `yaml:"prefix,omitempty"`
+	Suffix string `yaml:"suffix,omitempty"`
+}
+
+type GlobalConstraints struct {
+	ForbiddenPatterns      []PatternConstraint `yaml:"forbidden_patterns,omitempty"`
+	RequiredPatterns       []PatternConstraint `yaml:"required_patterns,omitempty"`
+	AffirmativeConstraints []string            `yaml:"affirmative_constraints,omitempty"`
+}
+
+type PatternConstraint struct {
+	Pattern string `yaml:"pattern"`
+	Message string `yaml:"message"`
+	When    string `yaml:"when,omitempty"`
+}
+
+type RalphyTask struct {
+	ID                   string            `yaml:"id"`
+	Title                string            `yaml:"title"`
+	Description          string            `yaml:"description"`
+	FilesInScope         []string          `yaml:"files_in_scope,omitempty"`
+	StyleAnchors         []TaskStyleAnchor `yaml:"style_anchors,omitempty"`
+	Dependencies         []string          `yaml:"dependencies,omitempty"`
+	SingleResponsibility string            `yaml:"single_responsibility,omitempty"`
+	AcceptanceCriteria   []string          `yaml:"acceptance_criteria,omitempty"`
+	Verification         TaskVerification  `yaml:"verification,omitempty"`
+}
+
+type TaskStyleAnchor struct {
+	File   string `yaml:"file"`
+	Reason string `yaml:"reason"`
+}
+
+type TaskVerification struct {
+	PreCommit  []string `yaml:"pre_commit,omitempty"`
+	PostCommit []string `yaml:"post_commit,omitempty"`
+	Runtime    []string `yaml:"runtime,omitempty"`
+}
+
+// TaskPromptData is the value passed to task prompt templates. Prefix and Suffix come
+// from prompt_template with their placeholders already substituted; StyleAnchors holds
+// the project-wide anchors the task does not already list.
+type TaskPromptData struct {
+	Project             string
+	RulesFile           string
+	Prefix              string
+	Suffix              string
+	StyleAnchors        []string
+	Constraints         []string
+	DisallowedFileEdits []string
+	TDDRequired         bool
+	TestCommands        []string
+	FailureInstruction  string
+	Task                RalphyTask
+}
+
+// RenderTaskPrompt renders the agent prompt for a single task in a Ralphy YAML file,
+// searching every document of a multi-document file for the task.
+// If templatePath is empty the built-in template is used; otherwise the file is parsed
+// as a text/template receiving a TaskPromptData value.
+func RenderTaskPrompt(yamlPath, taskID, templatePath string) (string, error) {
+	yamlBytes, err := os.ReadFile(yamlPath)
+	if err != nil {
+		return "", fmt.Errorf("failed to read YAML file %q: %w", yamlPath, err)
+	}
+
+	documents, err := shared.DecodeYAMLDocuments(yamlBytes)
+	if err != nil {
+		return "", err
+	}
+
+	configs := []*RalphyYAML{}
+	for index, document := range documents {
+		var config RalphyYAML
+		if err := document.Decode(&config); err != nil {
+			return "", fmt.Errorf("failed to parse YAML document %d: %w", index+1, err)
+		}
+		configs = append(configs, &config)
+	}
+
+	tmplText := defaultTaskPromptTemplate
+	if templatePath != "" {
+		tmplBytes, err := os.ReadFile(templatePath)
+		if err != nil {
+			return "", fmt.Errorf("failed to read template file %q: %w", templatePath, err)
+		}
+		tmplText = string(tmplBytes)
+	}
+
+	return renderTaskPrompt(configs, taskID, tmplText)
+}
+
+func renderTaskPrompt(configs []*RalphyYAML, taskID, tmplText string) (string, error) {
+	config, task, ok := findTask(configs, taskID)
+	if !ok {
+		return "", fmt.Errorf("task %q not found", taskID)
+	}
+
+	data := TaskPromptData{
+		Project:             config.Name,
+		RulesFile:           config.RulesFile,
+		Prefix:              substitutePromptPlaceholders(config.PromptTemplate.Prefix, config),
+		Suffix:              substitutePromptPlaceholders(config.PromptTemplate.Suffix, config),
+		StyleAnchors:        projectStyleAnchors(config.StyleAnchors, task),
+		Constraints:         collectConstraints(config.GlobalConstraints),
+		DisallowedFileEdits: config.Outputs.DisallowedFileEdits,
+		TDDRequired:         config.TDD.Required,
+		TestCommands:        config.TDD.TestCommand,
+		FailureInstruction:  config.TDD.FailureInstruction,
+		Task:                task,
+	}
+
+	funcs := template.FuncMap{"join": strings.Join, "trim": strings.TrimSpace}
+	tmpl, err := template.New("task-prompt").Funcs(funcs).Parse(tmplText)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse task prompt template: %w", err)
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return "", fmt.Errorf("failed to execute task prompt template: %w", err)
+	}
+
+	return buf.String(), nil
+}
+
+// findTask returns the first task with taskID across all documents, together with the
+// document it belongs to so the prompt uses that document's project settings.
+func findTask(configs []*RalphyYAML, taskID string) (*RalphyYAML, RalphyTask, bool) {
+	for _, config := range configs {
+		for _, task := range config.Tasks {
+			if task.ID == taskID {
+				return config, task, true
+			}
+		}
+	}
+	return nil, RalphyTask{}, false
+}
+
+// substitutePromptPlaceholders replaces the prompt_template placeholders in text.
+// Unknown placeholders are left as written.
+func substitutePromptPlaceholders(text string, config *RalphyYAML) string {
+	return strings.NewReplacer(
+		"{{rules_file}}", config.RulesFile,
+		"{{style_anchors}}", strings.Join(config.StyleAnchors, ", "),
+	).Replace(text)
+}
+
+func projectStyleAnchors(anchors []string, task RalphyTask) []string {
+	listed := make(map[string]bool)
+	for _, anchor := range task.StyleAnchors {
+		listed[anchor.File] = true
+	}
+
+	remaining := []string{}
+	for _, anchor := range anchors {
+		if !listed[anchor] {
+			remaining = append(remaining, anchor)
+		}
+	}
+	return remaining
+}
+
+// collectConstraints flattens global constraints into the messages an agent should follow.
+func collectConstraints(gc GlobalConstraints) []string {
+	constraints := []string{}
+	for _, c := range gc.ForbiddenPatterns {
+		constraints = append(constraints, patternConstraintText(c))
+	}
+	for _, c := range gc.RequiredPatterns {
+		constraints = append(constraints, patternConstraintText(c))
+	}
+	constraints = append(constraints, gc.AffirmativeConstraints...)
+	return constraints
+}
+
+func patternConstraintText(c PatternConstraint) string {
+	if c.When == "" {
+		return c.Message
+	}
+	return fmt.Sprintf("%s (when: %s)", c.Message, c.When)
+}
@@ -0,0 +1,248 @@
+package executor
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+)
+
+const taskPromptTestYAML = `name: demo
+rules_file: docs/rules.md
+style_anchors:
+  - docs/best-practices.md
+  - examples/greeter.go
+tdd:
+  required: true
+  test_command: ["go test ./...", "go vet ./..."]
+  failure_instruction: "Return failing tests; do not modify tests."
+outputs:
+  allowed_file_edits: ["pkg/**"]
+  disallowed_file_edits: [".github/**"]
+prompt_template:
+  prefix: "Project rules:\n{{rules_file}}\n\nStyle anchors: {{style_anchors}}\n\nTask:\n"
+  suffix: "\nMake a minimal diff. Commit after tests pass."
+global_constraints:
+  required_patterns:
+    - pattern: "t\\.Run\\("
+      when: "writing_tests"
+      message: "ALWAYS use t.Run() for subtests"
+  affirmative_constraints:
+    - "ALWAYS wrap errors with context"
+tasks:
+  - id: "task-001"
+    title: "Add greeter"
+    description: "Implement the greeter package."
+    files_in_scope:
+      - "pkg/greeter/greeter.go"
+      - "pkg/greeter/greeter_test.go"
+    style_anchors:
+      - file: "examples/greeter.go"
+        reason: "Greeter example"
+    single_responsibility: "Greeting logic"
+    acceptance_criteria:
+      - "Greet returns a greeting (test: go test ./pkg/greeter passes)"
+    verification:
+      pre_commit:
+        - "go test ./pkg/greeter"
+  - id: "task-002"
+    title: "Document greeter"
+    description: "Write the README section."
+    dependencies: ["task-001"]
+`
+
+func TestRenderTaskPrompt(t *testing.T) {
+	tmpDir := t.TempDir()
+	yamlPath := filepath.Join(tmpDir, "ralphy.yaml")
+	if err := os.WriteFile(yamlPath, []byte(taskPromptTestYAML), 0644); err != nil {
+		t.Fatalf("failed to write YAML: %v", err)
+	}
+
+	customTemplate := filepath.Join(tmpDir, "custom.tmpl")
+	customText := "{{.Task.ID}}|{{join .Task.FilesInScope \",\"}}|{{len .Constraints}}|{{join .TestCommands \";\"}}|{{join .DisallowedFileEdits \",\"}}|{{.Suffix}}"
+	if err := os.WriteFile(customTemplate, []byte(customText), 0644); err != nil {
+		t.Fatalf("failed to write template: %v", err)
+	}
+
+	tests := []struct {
+		name         string
+		taskID       string
+		templatePath string
+		wantErr      bool
+		errMsg       string
+		contains     []string
+		notContains  []string
+		wantPrefix   string
+		wantSuffix   string
+	}{
+		{
+			name:   "default template includes task details",
+			taskID: "task-001",
+			contains: []string{
+				"# Task task-001: Add greeter",
+				"Rules file: docs/rules.md",
+				"Implement the greeter package.",
+				"- pkg/greeter/greeter.go",
+				"- ALWAYS use t.Run() for subtests (when: writing_tests)",
+				"- ALWAYS wrap errors with context",
+				"- Greet returns a greeting (test: go test ./pkg/greeter passes)",
+				"Before committing, run:\n- go test ./pkg/greeter",
+				"Never modify:\n- .github/**",
+				"- examples/greeter.go: Greeter example\n- docs/best-practices.md\n",
+				"Write failing tests before the implementation (TDD required).",
+				"Run the test suite with:\n- go test ./...\n- go vet ./...",
+				"If tests fail: Return failing tests; do not modify tests.",
+			},
+			notContains: []string{"No verification commands declared", "{{rules_file}}", "- examples/greeter.go\n"},
+			wantPrefix:  "Project rules:\ndocs/rules.md\n\nStyle anchors: docs/best-practices.md, examples/greeter.go\n\nTask:\n\n# Task task-001",
+			wantSuffix:  "\n\nMake a minimal diff. Commit after tests pass.\n",
+		},
+		{
+			name:   "task without scope or verification",
+			taskID: "task-002",
+			contains: []string{
+				"Depends on: task-001",
+				"- (none declared)",
+				"No verification commands declared",
+			},
+			notContains: []string{"## Acceptance criteria"},
+		},
+		{
+			name:         "custom template",
+			taskID:       "task-001",
+			templatePath: customTemplate,
+			contains:     []string{"task-001|pkg/greeter/greeter.go,pkg/greeter/greeter_test.go|2|go test ./...;go vet ./...|.github/**|\nMake a minimal diff. Commit after tests pass."},
+		},
+		{
+			name:    "unknown task",
+			taskID:  "task-999",
+			wantErr: true,
+			errMsg:  `task "task-999" not found`,
+		},
+		{
+			name:         "missing template file",
+			taskID:       "task-001",
+			templatePath: filepath.Join(tmpDir, "missing.tmpl"),
+			wantErr:      true,
+			errMsg:       "failed to read template file",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := RenderTaskPrompt(yamlPath, tt.taskID, tt.templatePath)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error but got none")
+				}
+				if !strings.Contains(err.Error(), tt.errMsg) {
+					t.Errorf("error = %v, want containing %q", err, tt.errMsg)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			for _, s := range tt.contains {
+				if !strings.Contains(got, s) {
+					t.Errorf("prompt missing %q\nPrompt:\n%s", s, got)
+				}
+			}
+			for _, s := range tt.notContains {
+				if strings.Contains(got, s) {
+					t.Errorf("prompt unexpectedly contains %q\nPrompt:\n%s", s, got)
+				}
+			}
+			if !strings.HasPrefix(got, tt.wantPrefix) {
+				t.Errorf("prompt does not start with %q\nPrompt:\n%s", tt.wantPrefix, got)
+			}
+			if !strings.HasSuffix(got, tt.wantSuffix) {
+				t.Errorf("prompt does not end with %q\nPrompt:\n%s", tt.wantSuffix, got)
+			}
+		})
+	}
+}
+
+func TestRenderTaskPromptMultiDocument(t *testing.T) {
+	yamlPath := filepath.Join(t.TempDir(), "ralphy.yaml")
+	content := taskPromptTestYAML + `---
+name: second
+rules_file: docs/second-rules.md
+tasks:
+  - id: "b1"
+    title: "Add parser"
+    description: "Implement the parser package."
+---
+`
+	if err := os.WriteFile(yamlPath, []byte(content), 0644); err != nil {
+		t.Fatalf("failed to write YAML: %v", err)
+	}
+
+	got, err := RenderTaskPrompt(yamlPath, "b1", "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, s := range []string{"# Task b1: Add parser", "Rules file: docs/second-rules.md", "Implement the parser package."} {
+		if !strings.Contains(got, s) {
+			t.Errorf("prompt missing %q\nPrompt:\n%s", s, got)
+		}
+	}
+	for _, s := range []string{"docs/rules.md", "Project rules:", "Never modify:"} {
+		if strings.Contains(got, s) {
+			t.Errorf("prompt uses settings from another document: %q\nPrompt:\n%s", s, got)
+		}
+	}
+
+	if _, err := RenderTaskPrompt(yamlPath, "task-001", ""); err != nil {
+		t.Errorf("task in first document: unexpected error: %v", err)
+	}
+}
+
+func TestRenderTaskPromptInvalidDocument(t *testing.T) {
+	yamlPath := filepath.Join(t.TempDir(), "ralphy.yaml")
+	if err := os.WriteFile(yamlPath, []byte("tasks: []\n---\ntasks: [unclosed\n"), 0644); err != nil {
+		t.Fatalf("failed to write YAML: %v", err)
+	}
+
+	_, err := RenderTaskPrompt(yamlPath, "task-001", "")
+	if err == nil || !strings.Contains(err.Error(), "failed to parse YAML document 2") {
+		t.Errorf("error = %v, want parse failure for document 2", err)
+	}
+}
+
+func TestRenderTaskPromptMissingFile(t *testing.T) {
+	_, err := RenderTaskPrompt(filepath.Join(t.TempDir(), "missing.yaml"), "task-001", "")
+	if err == nil {
+		t.Fatal("expected error for missing YAML file")
+	}
+	if !strings.Contains(err.Error(), "failed to read YAML file") {
+		t.Errorf("error = %v, want read failure", err)
+	}
+}
+
+func TestTestCommandsUnmarshal(t *testing.T) {
+	tests := []struct {
+		name string
+		yaml string
+		want []string
+	}{
+		{name: "single command", yaml: "test_command: go test ./...", want: []string{"go test ./..."}},
+		{name: "command list", yaml: "test_command: [go test ./..., go vet ./...]", want: []string{"go test ./...", "go vet ./..."}},
+		{name: "absent", yaml: "required: true", want: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var tdd TDDConfig
+			if err := yaml.Unmarshal([]byte(tt.yaml), &tdd); err != nil {
+				t.Fatalf("yaml.Unmarshal() error = %v", err)
+			}
+			if !reflect.DeepEqual([]string(tdd.TestCommand), tt.want) {
+				t.Errorf("TestCommand = %q, want %q", tdd.TestCommand, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,42 @@
+package shared
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+
+	"gopkg.in/yaml.v3"
+)
+
+// DecodeYAMLDocuments splits data into its YAML documents. Empty documents, such as
+// a trailing "---" or a document holding only comments, are skipped. Errors number
+// documents from 1.
+func DecodeYAMLDocuments(data []byte) ([]*yaml.Node, error) {
+	documents := []*yaml.Node{}
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	for {
+		var node yaml.Node
+		if err := decoder.Decode(&node); err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, fmt.Errorf("failed to parse YAML document %d: %w", len(documents)+1, err)
+		}
+
+		if isEmptyYAMLDocument(&node) {
+			continue
+		}
+		documents = append(documents, &node)
+	}
+
+	return documents, nil
+}
+
+func isEmptyYAMLDocument(node *yaml.Node) bool {
+	if len(node.Content) == 0 {
+		return true
+	}
+	content := node.Content[0]
+	return content.Kind == yaml.ScalarNode && content.Tag == "!!null"
+}
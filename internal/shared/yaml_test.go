@@ -0,0 +1,94 @@
+package shared
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestDecodeYAMLDocuments(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		wantDocs int
+		wantErr  string
+	}{
+		{
+			name:     "single document",
+			input:    "name: demo\n",
+			wantDocs: 1,
+		},
+		{
+			name:     "multiple documents",
+			input:    "name: a\n---\nname: b\n---\nname: c\n",
+			wantDocs: 3,
+		},
+		{
+			name:     "leading and trailing separators",
+			input:    "---\nname: a\n---\n",
+			wantDocs: 1,
+		},
+		{
+			name:     "comment-only document",
+			input:    "name: a\n---\n# nothing yet\n---\nname: b\n",
+			wantDocs: 2,
+		},
+		{
+			name:     "explicit null document",
+			input:    "name: a\n---\n~\n",
+			wantDocs: 1,
+		},
+		{
+			name:     "empty input",
+			input:    "",
+			wantDocs: 0,
+		},
+		{
+			name:     "comments only",
+			input:    "# nothing here\n",
+			wantDocs: 0,
+		},
+		{
+			name:    "invalid second document",
+			input:   "name: a\n---\nname: [unclosed\n",
+			wantErr: "failed to parse YAML document 2",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			documents, err := DecodeYAMLDocuments([]byte(tt.input))
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("DecodeYAMLDocuments() error = %v, want containing %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("DecodeYAMLDocuments() error = %v", err)
+			}
+			if len(documents) != tt.wantDocs {
+				t.Errorf("documents = %d, want %d", len(documents), tt.wantDocs)
+			}
+		})
+	}
+}
+
+func TestDecodeYAMLDocumentsResolvesAnchors(t *testing.T) {
+	documents, err := DecodeYAMLDocuments([]byte("base: &base {name: demo}\nitem:\n  <<: *base\n  id: 1\n"))
+	if err != nil {
+		t.Fatalf("DecodeYAMLDocuments() error = %v", err)
+	}
+
+	var doc struct {
+		Item struct {
+			Name string `yaml:"name"`
+			ID   int    `yaml:"id"`
+		} `yaml:"item"`
+	}
+	if err := documents[0].Decode(&doc); err != nil {
+		t.Fatalf("Decode() error = %v", err)
+	}
+	if doc.Item.Name != "demo" || doc.Item.ID != 1 {
+		t.Errorf("item = %+v, want merged name demo and id 1", doc.Item)
+	}
+}
@@ -6,7 +6,7 @@ import (
 	"regexp"
 	"strings"
 
-	"gopkg.in/yaml.v3"
+	"github.com/kyledavis/prompt-stack/internal/shared"
 )
 
 const (
@@ -65,6 +65,7 @@ type PatternConstraint struct {
 
 type ValidationResult struct {
 	Valid            bool        `json:"valid"`
+	Documents        int         `json:"documents"`
 	TotalConstraints int         `json:"total_constraints"`
 	AffirmativeCount int         `json:"affirmative_count"`
 	NegativeCount    int         `json:"negative_count"`
@@ -74,6 +75,7 @@ type ValidationResult struct {
 }
 
 type Violation struct {
+	Document       int    `json:"document"`
 	ConstraintType string `json:"constraint_type"`
 	ConstraintText string `json:"constraint_text"`
 	Issue          string `json:"issue"`
@@ -88,18 +90,73 @@ type Summary struct {
 	PatternReferences     int     `json:"pattern_references"`
 }
 
-func LoadYAML(yamlPath string) (*RalphyYAML, error) {
+// LoadYAML returns one config per YAML document in the file. A file without any
+// documents yields a single empty config.
+func LoadYAML(yamlPath string) ([]*RalphyYAML, error) {
 	yamlBytes, err := os.ReadFile(yamlPath)
 	if err != nil {
 		return nil, fmt.Errorf("failed to read YAML file %q: %w", yamlPath, err)
 	}
 
-	var config RalphyYAML
-	if err := yaml.Unmarshal(yamlBytes, &config); err != nil {
-		return nil, fmt.Errorf("failed to parse YAML: %w", err)
+	documents, err := shared.DecodeYAMLDocuments(yamlBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	configs := []*RalphyYAML{}
+	for index, document := range documents {
+		var config RalphyYAML
+		if err := document.Decode(&config); err != nil {
+			return nil, fmt.Errorf("failed to parse YAML document %d: %w", index+1, err)
+		}
+		configs = append(configs, &config)
+	}
+
+	if len(configs) == 0 {
+		configs = append(configs, &RalphyYAML{})
+	}
+
+	return configs, nil
+}
+
+// validateDocuments validates each document and merges the results, tagging every
+// violation with the number (from 1) of the document it came from.
+func validateDocuments(configs []*RalphyYAML) ValidationResult {
+	merged := ValidationResult{
+		Valid:           true,
+		Documents:       len(configs),
+		Violations:      []Violation{},
+		Recommendations: []string{},
+	}
+
+	for index, config := range configs {
+		result := ValidateConstraints(config)
+
+		merged.Valid = merged.Valid && result.Valid
+		merged.TotalConstraints += result.TotalConstraints
+		merged.AffirmativeCount += result.AffirmativeCount
+		merged.NegativeCount += result.NegativeCount
+		merged.Summary.SpecificConstraints += result.Summary.SpecificConstraints
+		merged.Summary.VagueConstraints += result.Summary.VagueConstraints
+		merged.Summary.PatternReferences += result.Summary.PatternReferences
+
+		for _, v := range result.Violations {
+			v.Document = index + 1
+			merged.Violations = append(merged.Violations, v)
+		}
+		for _, rec := range result.Recommendations {
+			if len(configs) > 1 {
+				rec = fmt.Sprintf("document %d: %s", index+1, rec)
+			}
+			merged.Recommendations = append(merged.Recommendations, rec)
+		}
+	}
+
+	if merged.TotalConstraints > 0 {
+		merged.Summary.AffirmativePercentage = float64(merged.AffirmativeCount) / float64(merged.TotalConstraints) * 100
 	}
 
-	return &config, nil
+	return merged
 }
 
 func ValidateConstraints(config *RalphyYAML) ValidationResult {
@@ -289,12 +346,12 @@ func containsPatternReference(text string) bool {
 }
 
 func ValidateConstraintsFromFile(yamlPath string) (int, *ValidationResult, error) {
-	config, err := LoadYAML(yamlPath)
+	configs, err := LoadYAML(yamlPath)
 	if err != nil {
 		return ExitExecution, nil, err
 	}
 
-	result := ValidateConstraints(config)
+	result := validateDocuments(configs)
 
 	if !result.Valid {
 		return ExitFailed, &result, nil
@@ -0,0 +1,132 @@
+package constraints
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func writeYAML(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "ralphy.yaml")
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatalf("failed to write YAML: %v", err)
+	}
+	return path
+}
+
+func TestLoadYAML(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		wantDocs int
+		wantErr  string
+	}{
+		{
+			name:     "single document",
+			content:  "global_constraints:\n  affirmative_constraints: [\"Always wrap errors\"]\n",
+			wantDocs: 1,
+		},
+		{
+			name:     "trailing separator",
+			content:  "global_constraints:\n  affirmative_constraints: [\"Always wrap errors\"]\n---\n",
+			wantDocs: 1,
+		},
+		{
+			name:     "multiple documents",
+			content:  "name: a\n---\nname: b\n",
+			wantDocs: 2,
+		},
+		{
+			name:     "empty file",
+			content:  "",
+			wantDocs: 1,
+		},
+		{
+			name:    "invalid second document",
+			content: "name: a\n---\nname: [unclosed\n",
+			wantErr: "failed to parse YAML document 2",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			configs, err := LoadYAML(writeYAML(t, tt.content))
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("LoadYAML() error = %v, want containing %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("LoadYAML() error = %v", err)
+			}
+			if len(configs) != tt.wantDocs {
+				t.Errorf("documents = %d, want %d", len(configs), tt.wantDocs)
+			}
+		})
+	}
+}
+
+func TestValidateConstraintsFromFileMultiDocument(t *testing.T) {
+	path := writeYAML(t, `global_constraints:
+  affirmative_constraints:
+    - "Always wrap errors with fmt.Errorf, following internal/shared/yaml.go"
+---
+global_constraints:
+  affirmative_constraints:
+    - "Always validate inputs at the CLI boundary, following cmd/prompt-stack/main.go"
+    - "Never log secrets"
+`)
+
+	exitCode, result, err := ValidateConstraintsFromFile(path)
+	if err != nil {
+		t.Fatalf("ValidateConstraintsFromFile() error = %v", err)
+	}
+	if exitCode != ExitFailed {
+		t.Errorf("exit code = %d, want %d", exitCode, ExitFailed)
+	}
+	if result.Documents != 2 {
+		t.Errorf("Documents = %d, want 2", result.Documents)
+	}
+	if result.TotalConstraints != 3 || result.AffirmativeCount != 2 || result.NegativeCount != 1 {
+		t.Errorf("total/affirmative/negative = %d/%d/%d, want 3/2/1",
+			result.TotalConstraints, result.AffirmativeCount, result.NegativeCount)
+	}
+	if len(result.Violations) != 1 {
+		t.Fatalf("violations = %+v, want 1", result.Violations)
+	}
+	if v := result.Violations[0]; v.Document != 2 || v.Issue != "negative_phrasing" {
+		t.Errorf("violation = %+v, want negative_phrasing in document 2", v)
+	}
+	for _, rec := range result.Recommendations {
+		if !strings.HasPrefix(rec, "document ") {
+			t.Errorf("recommendation %q is not tagged with its document", rec)
+		}
+	}
+}
+
+func TestValidateConstraintsFromFileSingleDocument(t *testing.T) {
+	path := writeYAML(t, `global_constraints:
+  affirmative_constraints:
+    - "Always wrap errors with fmt.Errorf, following internal/shared/yaml.go"
+---
+`)
+
+	exitCode, result, err := ValidateConstraintsFromFile(path)
+	if err != nil {
+		t.Fatalf("ValidateConstraintsFromFile() error = %v", err)
+	}
+	if exitCode != ExitSuccess {
+		t.Errorf("exit code = %d, want %d; violations: %+v", exitCode, ExitSuccess, result.Violations)
+	}
+	if result.Documents != 1 || result.TotalConstraints != 1 {
+		t.Errorf("documents/constraints = %d/%d, want 1/1", result.Documents, result.TotalConstraints)
+	}
+	for _, rec := range result.Recommendations {
+		if strings.HasPrefix(rec, "document ") {
+			t.Errorf("single-document recommendation %q should not be tagged", rec)
+		}
+	}
+}
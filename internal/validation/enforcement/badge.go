@@ -0,0 +1,92 @@
+package enforcement
+
+import (
+	"bytes"
+	"fmt"
+	"html"
+	"text/template"
+)
+
+const (
+	badgeLabel     = "enforcement"
+	badgePassColor = "#4c1"
+	badgeFailColor = "#e05d44"
+	badgeCharWidth = 7
+	badgePadding   = 10
+)
+
+const badgeTemplate = `<svg xmlns="http://www.w3.org/2000/svg" width="{{.Width}}" height="20" role="img" aria-label="{{.Label}}: {{.Message}}">
+  <title>{{.Label}}: {{.Message}}</title>
+  <linearGradient id="s" x2="0" y2="100%">
+    <stop offset="0" stop-color="#bbb" stop-opacity=".1"/>
+    <stop offset="1" stop-opacity=".1"/>
+  </linearGradient>
+  <clipPath id="r">
+    <rect width="{{.Width}}" height="20" rx="3" fill="#fff"/>
+  </clipPath>
+  <g clip-path="url(#r)">
+    <rect width="{{.LabelWidth}}" height="20" fill="#555"/>
+    <rect x="{{.LabelWidth}}" width="{{.MessageWidth}}" height="20" fill="{{.Color}}"/>
+    <rect width="{{.Width}}" height="20" fill="url(#s)"/>
+  </g>
+  <g fill="#fff" text-anchor="middle" font-family="Verdana,Geneva,DejaVu Sans,sans-serif" font-size="11">
+    <text x="{{.LabelX}}" y="14">{{.Label}}</text>
+    <text x="{{.MessageX}}" y="14">{{.Message}}</text>
+  </g>
+</svg>
+`
+
+type badgeData struct {
+	Label        string
+	Message      string
+	Color        string
+	Width        int
+	LabelWidth   int
+	MessageWidth int
+	LabelX       int
+	MessageX     int
+}
+
+// GenerateBadge renders a flat SVG badge summarising an enforcement result,
+// e.g. "enforcement | 4/5 layers · pass". It needs no external badge service.
+func GenerateBadge(result *ValidationResult) (string, error) {
+	status := "pass"
+	color := badgePassColor
+	if !result.Valid {
+		status = "fail"
+		color = badgeFailColor
+	}
+
+	message := fmt.Sprintf("%d/%d layers · %s", result.VerificationLayers.TotalLayers, totalVerificationLayers, status)
+
+	labelWidth := badgeTextWidth(badgeLabel)
+	messageWidth := badgeTextWidth(message)
+
+	data := badgeData{
+		Label:        html.EscapeString(badgeLabel),
+		Message:      html.EscapeString(message),
+		Color:        color,
+		Width:        labelWidth + messageWidth,
+		LabelWidth:   labelWidth,
+		MessageWidth: messageWidth,
+		LabelX:       labelWidth / 2,
+		MessageX:     labelWidth + messageWidth/2,
+	}
+
+	tmpl, err := template.New("badge").Parse(badgeTemplate)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse badge template: %w", err)
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return "", fmt.Errorf("failed to execute badge template: %w", err)
+	}
+
+	return buf.String(), nil
+}
+
+// badgeTextWidth approximates rendered text width for 11px Verdana.
+func badgeTextWidth(text string) int {
+	return len([]rune(text))*badgeCharWidth + badgePadding
+}
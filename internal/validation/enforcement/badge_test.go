@@ -0,0 +1,54 @@
+package enforcement
+
+import (
+	"encoding/xml"
+	"strings"
+	"testing"
+)
+
+func TestGenerateBadge(t *testing.T) {
+	tests := []struct {
+		name      string
+		result    ValidationResult
+		wantText  string
+		wantColor string
+	}{
+		{
+			name: "passing result",
+			result: ValidationResult{
+				Valid:              true,
+				VerificationLayers: VerificationLayers{TotalLayers: 5},
+			},
+			wantText:  "5/5 layers · pass",
+			wantColor: badgePassColor,
+		},
+		{
+			name: "failing result",
+			result: ValidationResult{
+				Valid:              false,
+				VerificationLayers: VerificationLayers{TotalLayers: 2},
+			},
+			wantText:  "2/5 layers · fail",
+			wantColor: badgeFailColor,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			badge, err := GenerateBadge(&tt.result)
+			if err != nil {
+				t.Fatalf("GenerateBadge() error = %v", err)
+			}
+
+			if err := xml.Unmarshal([]byte(badge), new(struct{})); err != nil {
+				t.Errorf("badge is not well-formed XML: %v", err)
+			}
+			if !strings.Contains(badge, tt.wantText) {
+				t.Errorf("badge missing text %q\nBadge: %s", tt.wantText, badge)
+			}
+			if !strings.Contains(badge, `fill="`+tt.wantColor+`"`) {
+				t.Errorf("badge missing color %q\nBadge: %s", tt.wantColor, badge)
+			}
+		})
+	}
+}
@@ -4,7 +4,7 @@ import (
 	"fmt"
 	"os"
 
-	"gopkg.in/yaml.v3"
+	"github.com/kyledavis/prompt-stack/internal/shared"
 )
 
 const (
@@ -13,7 +13,10 @@ const (
 	ExitExecution = 2
 )
 
-const minVerificationLayers = 3
+const (
+	minVerificationLayers   = 3
+	totalVerificationLayers = 5
+)
 
 type RalphyYAML struct {
 	Name              string            `yaml:"name"`
@@ -73,15 +76,17 @@ type Verification struct {
 }
 
 type ValidationResult struct {
-	Valid                 bool               `json:"valid"`
-	TotalTasks            int                `json:"total_tasks"`
-	TasksWithFilesInScope int                `json:"tasks_with_files_in_scope"`
-	TasksWithVerification int                `json:"tasks_with_verification"`
-	VerificationLayers    VerificationLayers `json:"verification_layers"`
-	CommitPolicy          CommitPolicyStatus `json:"commit_policy"`
-	ScopeEnforcement      ScopeEnforcement   `json:"scope_enforcement"`
-	Violations            []Violation        `json:"violations,omitempty"`
-	Recommendations       []string           `json:"recommendations,omitempty"`
+	Valid                 bool                 `json:"valid"`
+	Documents             int                  `json:"documents"`
+	TotalTasks            int                  `json:"total_tasks"`
+	TasksWithFilesInScope int                  `json:"tasks_with_files_in_scope"`
+	TasksWithVerification int                  `json:"tasks_with_verification"`
+	VerificationLayers    VerificationLayers   `json:"verification_layers"`
+	CommitPolicy          CommitPolicyStatus   `json:"commit_policy"`
+	ScopeEnforcement      ScopeEnforcement     `json:"scope_enforcement"`
+	VerificationCoverage  VerificationCoverage `json:"verification_coverage"`
+	Violations            []Violation          `json:"violations,omitempty"`
+	Recommendations       []string             `json:"recommendations,omitempty"`
 }
 
 type VerificationLayers struct {
@@ -108,24 +113,132 @@ type ScopeEnforcement struct {
 }
 
 type Violation struct {
+	Document    int    `json:"document"`
 	Type        string `json:"type"`
 	Description string `json:"description"`
 	TaskID      string `json:"task_id,omitempty"`
 	Suggestion  string `json:"suggestion,omitempty"`
 }
 
-func LoadYAML(yamlPath string) (*RalphyYAML, error) {
+// LoadYAML returns one config per YAML document in the file. A file without any
+// documents yields a single empty config.
+func LoadYAML(yamlPath string) ([]*RalphyYAML, error) {
 	yamlBytes, err := os.ReadFile(yamlPath)
 	if err != nil {
 		return nil, fmt.Errorf("failed to read YAML file %q: %w", yamlPath, err)
 	}
 
-	var config RalphyYAML
-	if err := yaml.Unmarshal(yamlBytes, &config); err != nil {
-		return nil, fmt.Errorf("failed to parse YAML: %w", err)
+	documents, err := shared.DecodeYAMLDocuments(yamlBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	configs := []*RalphyYAML{}
+	for index, document := range documents {
+		var config RalphyYAML
+		if err := document.Decode(&config); err != nil {
+			return nil, fmt.Errorf("failed to parse YAML document %d: %w", index+1, err)
+		}
+		configs = append(configs, &config)
+	}
+
+	if len(configs) == 0 {
+		configs = append(configs, &RalphyYAML{})
 	}
 
-	return &config, nil
+	return configs, nil
+}
+
+// validateDocuments validates each document and merges the results, tagging every
+// violation with the number (from 1) of the document it came from. A layer or policy
+// only counts as present when every document declares it.
+func validateDocuments(configs []*RalphyYAML) ValidationResult {
+	merged := ValidationResult{
+		Valid:     true,
+		Documents: len(configs),
+		VerificationLayers: VerificationLayers{
+			PromptLevel:    true,
+			IDEIntegration: true,
+			PreCommit:      true,
+			CIChecks:       true,
+			Runtime:        true,
+		},
+		CommitPolicy: CommitPolicyStatus{
+			HasPrefixRules:         true,
+			HasScopeRequirement:    true,
+			HasConventionalCommits: true,
+			Complete:               true,
+		},
+		ScopeEnforcement: ScopeEnforcement{
+			HasAllowedFileEdits:      true,
+			HasDisallowedFileEdits:   true,
+			AllTasksHaveFilesInScope: true,
+			Complete:                 true,
+		},
+		VerificationCoverage: VerificationCoverage{
+			UniqueCommands:             []string{},
+			SharedVerification:         []SharedVerification{},
+			TasksWithoutScopeReference: []string{},
+			TasksModuleWideOnly:        []string{},
+		},
+		Violations:      []Violation{},
+		Recommendations: []string{},
+	}
+
+	seenCommands := make(map[string]bool)
+	for index, config := range configs {
+		result := ValidateEnforcement(config)
+
+		merged.Valid = merged.Valid && result.Valid
+		merged.TotalTasks += result.TotalTasks
+		merged.TasksWithFilesInScope += result.TasksWithFilesInScope
+		merged.TasksWithVerification += result.TasksWithVerification
+
+		layers := &merged.VerificationLayers
+		layers.PromptLevel = layers.PromptLevel && result.VerificationLayers.PromptLevel
+		layers.IDEIntegration = layers.IDEIntegration && result.VerificationLayers.IDEIntegration
+		layers.PreCommit = layers.PreCommit && result.VerificationLayers.PreCommit
+		layers.CIChecks = layers.CIChecks && result.VerificationLayers.CIChecks
+		layers.Runtime = layers.Runtime && result.VerificationLayers.Runtime
+
+		policy := &merged.CommitPolicy
+		policy.HasPrefixRules = policy.HasPrefixRules && result.CommitPolicy.HasPrefixRules
+		policy.HasScopeRequirement = policy.HasScopeRequirement && result.CommitPolicy.HasScopeRequirement
+		policy.HasConventionalCommits = policy.HasConventionalCommits && result.CommitPolicy.HasConventionalCommits
+		policy.Complete = policy.Complete && result.CommitPolicy.Complete
+
+		scope := &merged.ScopeEnforcement
+		scope.HasAllowedFileEdits = scope.HasAllowedFileEdits && result.ScopeEnforcement.HasAllowedFileEdits
+		scope.HasDisallowedFileEdits = scope.HasDisallowedFileEdits && result.ScopeEnforcement.HasDisallowedFileEdits
+		scope.AllTasksHaveFilesInScope = scope.AllTasksHaveFilesInScope && result.ScopeEnforcement.AllTasksHaveFilesInScope
+		scope.Complete = scope.Complete && result.ScopeEnforcement.Complete
+
+		coverage := &merged.VerificationCoverage
+		coverage.TotalCommands += result.VerificationCoverage.TotalCommands
+		for _, command := range result.VerificationCoverage.UniqueCommands {
+			if !seenCommands[command] {
+				seenCommands[command] = true
+				coverage.UniqueCommands = append(coverage.UniqueCommands, command)
+			}
+		}
+		coverage.SharedVerification = append(coverage.SharedVerification, result.VerificationCoverage.SharedVerification...)
+		coverage.TasksWithoutScopeReference = append(coverage.TasksWithoutScopeReference, result.VerificationCoverage.TasksWithoutScopeReference...)
+		coverage.TasksModuleWideOnly = append(coverage.TasksModuleWideOnly, result.VerificationCoverage.TasksModuleWideOnly...)
+
+		for _, v := range result.Violations {
+			v.Document = index + 1
+			merged.Violations = append(merged.Violations, v)
+		}
+		for _, rec := range result.Recommendations {
+			if len(configs) > 1 {
+				rec = fmt.Sprintf("document %d: %s", index+1, rec)
+			}
+			merged.Recommendations = append(merged.Recommendations, rec)
+		}
+	}
+
+	merged.VerificationLayers.TotalLayers = countVerificationLayers(merged.VerificationLayers)
+	return merged
 }
 
 func ValidateEnforcement(config *RalphyYAML) ValidationResult {
@@ -143,6 +256,7 @@ func ValidateEnforcement(config *RalphyYAML) ValidationResult {
 	result.CommitPolicy = checkCommitPolicy(config)
 	result.ScopeEnforcement = checkScopeEnforcement(config)
 	result = checkTasks(config, result)
+	result = checkVerificationCoverage(config, result)
 	result = validateRequirements(config, result)
 
 	return result
@@ -173,27 +287,21 @@ func checkVerificationLayers(config *RalphyYAML) VerificationLayers {
 		layers.Runtime = true
 	}
 
-	total := 0
-	if layers.PromptLevel {
-		total++
-	}
-	if layers.IDEIntegration {
-		total++
-	}
-	if layers.PreCommit {
-		total++
-	}
-	if layers.CIChecks {
-		total++
-	}
-	if layers.Runtime {
-		total++
-	}
-	layers.TotalLayers = total
+	layers.TotalLayers = countVerificationLayers(layers)
 
 	return layers
 }
 
+func countVerificationLayers(layers VerificationLayers) int {
+	total := 0
+	for _, present := range []bool{layers.PromptLevel, layers.IDEIntegration, layers.PreCommit, layers.CIChecks, layers.Runtime} {
+		if present {
+			total++
+		}
+	}
+	return total
+}
+
 func checkCommitPolicy(config *RalphyYAML) CommitPolicyStatus {
 	status := CommitPolicyStatus{}
 
@@ -307,9 +415,9 @@ func validateRequirements(config *RalphyYAML, result ValidationResult) Validatio
 		})
 	}
 
-	if result.VerificationLayers.TotalLayers < 5 {
+	if result.VerificationLayers.TotalLayers < totalVerificationLayers {
 		result.Recommendations = append(result.Recommendations,
-			fmt.Sprintf("Add more verification layers (currently %d/5)", result.VerificationLayers.TotalLayers))
+			fmt.Sprintf("Add more verification layers (currently %d/%d)", result.VerificationLayers.TotalLayers, totalVerificationLayers))
 	}
 
 	if !result.CommitPolicy.HasScopeRequirement {
@@ -331,12 +439,12 @@ func validateRequirements(config *RalphyYAML, result ValidationResult) Validatio
 }
 
 func ValidateEnforcementFromFile(yamlPath string) (int, *ValidationResult, error) {
-	config, err := LoadYAML(yamlPath)
+	configs, err := LoadYAML(yamlPath)
 	if err != nil {
 		return ExitExecution, nil, err
 	}
 
-	result := ValidateEnforcement(config)
+	result := validateDocuments(configs)
 
 	if !result.Valid {
 		return ExitFailed, &result, nil
@@ -0,0 +1,138 @@
+package enforcement
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func writeYAML(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "ralphy.yaml")
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatalf("failed to write YAML: %v", err)
+	}
+	return path
+}
+
+func TestLoadYAML(t *testing.T) {
+	tests := []struct {
+		name      string
+		content   string
+		wantTasks []int
+		wantErr   string
+	}{
+		{
+			name:      "single document",
+			content:   "tasks:\n  - id: t1\n",
+			wantTasks: []int{1},
+		},
+		{
+			name:      "trailing separator",
+			content:   "tasks:\n  - id: t1\n---\n",
+			wantTasks: []int{1},
+		},
+		{
+			name:      "multiple documents",
+			content:   "tasks:\n  - id: t1\n---\ntasks:\n  - id: t2\n  - id: t3\n",
+			wantTasks: []int{1, 2},
+		},
+		{
+			name:      "empty file",
+			content:   "# nothing here\n",
+			wantTasks: []int{0},
+		},
+		{
+			name:    "invalid second document",
+			content: "tasks: []\n---\ntasks: [unclosed\n",
+			wantErr: "failed to parse YAML document 2",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			configs, err := LoadYAML(writeYAML(t, tt.content))
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("LoadYAML() error = %v, want containing %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("LoadYAML() error = %v", err)
+			}
+			if len(configs) != len(tt.wantTasks) {
+				t.Fatalf("documents = %d, want %d", len(configs), len(tt.wantTasks))
+			}
+			for i, want := range tt.wantTasks {
+				if got := len(configs[i].Tasks); got != want {
+					t.Errorf("document %d tasks = %d, want %d", i+1, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestValidateEnforcementFromFileMultiDocument(t *testing.T) {
+	document := `rules_file: docs/rules.md
+ci:
+  precommit: [go vet ./...]
+  ci_checks: [go test ./...]
+global_constraints:
+  affirmative_constraints: ["ALWAYS wrap errors"]
+outputs:
+  allowed_file_edits: ["pkg/**"]
+  disallowed_file_edits: [".github/**"]
+  commit_policy:
+    prefix_rules: ["feat:"]
+`
+	path := writeYAML(t, document+`tasks:
+  - id: a1
+    files_in_scope: [pkg/a/a.go]
+    verification:
+      pre_commit: [go test ./pkg/a]
+---
+`+document+`drift_policy_ref: docs/drift.md
+tasks:
+  - id: b1
+    verification:
+      pre_commit: [go test ./pkg/b]
+`)
+
+	exitCode, result, err := ValidateEnforcementFromFile(path)
+	if err != nil {
+		t.Fatalf("ValidateEnforcementFromFile() error = %v", err)
+	}
+	if exitCode != ExitFailed {
+		t.Errorf("exit code = %d, want %d", exitCode, ExitFailed)
+	}
+	if result.Documents != 2 || result.TotalTasks != 2 {
+		t.Errorf("documents/tasks = %d/%d, want 2/2", result.Documents, result.TotalTasks)
+	}
+	if result.TasksWithFilesInScope != 1 {
+		t.Errorf("TasksWithFilesInScope = %d, want 1", result.TasksWithFilesInScope)
+	}
+	if result.VerificationLayers.Runtime || result.VerificationLayers.TotalLayers != 4 {
+		t.Errorf("layers = %+v, want runtime missing from document 1 and 4 layers", result.VerificationLayers)
+	}
+
+	found := false
+	for _, v := range result.Violations {
+		if v.Type == "missing_files_in_scope" {
+			found = true
+			if v.Document != 2 || v.TaskID != "b1" {
+				t.Errorf("violation = %+v, want document 2 task b1", v)
+			}
+		}
+	}
+	if !found {
+		t.Errorf("missing missing_files_in_scope violation in %+v", result.Violations)
+	}
+
+	for _, rec := range result.Recommendations {
+		if !strings.HasPrefix(rec, "document ") {
+			t.Errorf("recommendation %q is not tagged with its document", rec)
+		}
+	}
+}
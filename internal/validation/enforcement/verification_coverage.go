@@ -0,0 +1,244 @@
+package enforcement
+
+import (
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+)
+
+// VerificationCoverage summarises how task verification commands relate to each other
+// and to the files each task is allowed to touch.
+type VerificationCoverage struct {
+	TotalCommands              int                  `json:"total_commands"`
+	UniqueCommands             []string             `json:"unique_commands,omitempty"`
+	SharedVerification         []SharedVerification `json:"shared_verification,omitempty"`
+	TasksWithoutScopeReference []string             `json:"tasks_without_scope_reference,omitempty"`
+	TasksModuleWideOnly        []string             `json:"tasks_module_wide_only,omitempty"`
+}
+
+// SharedVerification is a group of tasks declaring an identical set of verification commands.
+type SharedVerification struct {
+	TaskIDs  []string `json:"task_ids"`
+	Commands []string `json:"commands"`
+}
+
+func checkVerificationCoverage(config *RalphyYAML, result ValidationResult) ValidationResult {
+	coverage := VerificationCoverage{
+		UniqueCommands:             []string{},
+		SharedVerification:         []SharedVerification{},
+		TasksWithoutScopeReference: []string{},
+		TasksModuleWideOnly:        []string{},
+	}
+
+	seen := make(map[string]bool)
+	groupIndex := make(map[string]int)
+	groups := []SharedVerification{}
+
+	for _, task := range config.Tasks {
+		commands := taskVerificationCommands(task)
+		if len(commands) == 0 {
+			continue
+		}
+
+		coverage.TotalCommands += len(commands)
+		for _, command := range commands {
+			if !seen[command] {
+				seen[command] = true
+				coverage.UniqueCommands = append(coverage.UniqueCommands, command)
+			}
+		}
+
+		key := verificationKey(commands)
+		if i, ok := groupIndex[key]; ok {
+			groups[i].TaskIDs = append(groups[i].TaskIDs, task.ID)
+		} else {
+			groupIndex[key] = len(groups)
+			groups = append(groups, SharedVerification{
+				TaskIDs:  []string{task.ID},
+				Commands: commands,
+			})
+		}
+
+		if len(task.FilesInScope) > 0 && !verificationReferencesScope(commands, task.FilesInScope) {
+			if verificationIsModuleWide(commands) {
+				coverage.TasksModuleWideOnly = append(coverage.TasksModuleWideOnly, task.ID)
+				result.Violations = append(result.Violations, Violation{
+					Type:        "verification_module_wide_only",
+					Description: fmt.Sprintf("Task %q verification only runs module-wide commands (e.g., ./...) and does not target files_in_scope", task.ID),
+					TaskID:      task.ID,
+					Suggestion:  "Add a verification command scoped to the files in files_in_scope (e.g., go test ./<package>)",
+				})
+			} else {
+				coverage.TasksWithoutScopeReference = append(coverage.TasksWithoutScopeReference, task.ID)
+				result.Violations = append(result.Violations, Violation{
+					Type:        "verification_outside_scope",
+					Description: fmt.Sprintf("Task %q verification does not reference any file in files_in_scope", task.ID),
+					TaskID:      task.ID,
+					Suggestion:  "Add a verification command that exercises the files in files_in_scope (e.g., go test ./<package>)",
+				})
+			}
+		}
+	}
+
+	for _, group := range groups {
+		if len(group.TaskIDs) < 2 {
+			continue
+		}
+		coverage.SharedVerification = append(coverage.SharedVerification, group)
+		result.Violations = append(result.Violations, Violation{
+			Type:        "shared_verification",
+			Description: fmt.Sprintf("Tasks %s share identical verification commands", strings.Join(group.TaskIDs, ", ")),
+			Suggestion:  "Tailor verification to each task so it checks the task's own deliverables",
+		})
+	}
+
+	if len(coverage.SharedVerification) > 0 {
+		result.Recommendations = append(result.Recommendations,
+			fmt.Sprintf("Differentiate verification for %d group(s) of tasks sharing identical commands", len(coverage.SharedVerification)))
+	}
+
+	if len(coverage.TasksWithoutScopeReference) > 0 {
+		result.Recommendations = append(result.Recommendations,
+			fmt.Sprintf("Reference files_in_scope in verification for %d task(s)", len(coverage.TasksWithoutScopeReference)))
+	}
+
+	if len(coverage.TasksModuleWideOnly) > 0 {
+		result.Recommendations = append(result.Recommendations,
+			fmt.Sprintf("Scope verification to files_in_scope for %d task(s) that only run module-wide commands", len(coverage.TasksModuleWideOnly)))
+	}
+
+	result.VerificationCoverage = coverage
+	return result
+}
+
+// taskVerificationCommands returns the task's verification commands across all layers,
+// trimmed and without duplicates, in declaration order.
+func taskVerificationCommands(task Task) []string {
+	commands := []string{}
+	seen := make(map[string]bool)
+	layers := [][]string{task.Verification.PreCommit, task.Verification.PostCommit, task.Verification.Runtime}
+	for _, layer := range layers {
+		for _, command := range layer {
+			command = strings.TrimSpace(command)
+			if command == "" || seen[command] {
+				continue
+			}
+			seen[command] = true
+			commands = append(commands, command)
+		}
+	}
+	return commands
+}
+
+func verificationKey(commands []string) string {
+	sorted := append([]string(nil), commands...)
+	sort.Strings(sorted)
+	return strings.Join(sorted, "\n")
+}
+
+func verificationReferencesScope(commands, filesInScope []string) bool {
+	for _, command := range commands {
+		for _, file := range filesInScope {
+			if commandReferencesFile(command, file) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// verificationIsModuleWide reports whether the commands run against the whole module and
+// none of them targets a specific path. A task mixing "go vet ./..." with a scoped command
+// for the wrong package is not module-wide only; its scoped command simply misses.
+func verificationIsModuleWide(commands []string) bool {
+	moduleWide := false
+	for _, command := range commands {
+		for _, arg := range commandPathArgs(command) {
+			switch {
+			case isModuleWidePattern(arg):
+				moduleWide = true
+			case looksLikePath(arg):
+				return false
+			}
+		}
+	}
+	return moduleWide
+}
+
+// commandReferencesFile reports whether any path argument of command names file or a
+// directory containing it. Module-wide patterns ("./...", ".") cover every file and so
+// say nothing about the task's scope; they never count as a reference.
+func commandReferencesFile(command, file string) bool {
+	file = normalizePathPattern(file)
+	if file == "" {
+		return false
+	}
+
+	for _, arg := range commandPathArgs(command) {
+		if isModuleWidePattern(arg) {
+			continue
+		}
+		arg = normalizePathPattern(strings.TrimSuffix(arg, "/..."))
+		if arg == "" {
+			continue
+		}
+
+		if arg == file ||
+			strings.HasPrefix(file, arg+"/") ||
+			strings.HasPrefix(arg, file+"/") ||
+			(!strings.Contains(arg, "/") && arg == path.Base(file)) {
+			return true
+		}
+	}
+	return false
+}
+
+// flagsWithValues lists common verification tool flags whose next argument is a value
+// (a test pattern, count, duration, ...) rather than a path.
+var flagsWithValues = map[string]bool{
+	"bench": true, "benchtime": true, "C": true, "count": true, "coverpkg": true,
+	"coverprofile": true, "cpu": true, "fuzz": true, "fuzztime": true, "ldflags": true,
+	"mod": true, "o": true, "p": true, "parallel": true, "run": true, "skip": true,
+	"tags": true, "timeout": true,
+}
+
+// commandPathArgs returns the arguments of command that may name paths: everything
+// except flags and the values of flags listed in flagsWithValues.
+func commandPathArgs(command string) []string {
+	args := []string{}
+	fields := strings.Fields(command)
+	for i := 0; i < len(fields); i++ {
+		arg := strings.Trim(fields[i], `"'`)
+		if strings.HasPrefix(arg, "-") {
+			name := strings.TrimLeft(arg, "-")
+			if !strings.Contains(name, "=") && flagsWithValues[name] {
+				i++
+			}
+			continue
+		}
+		args = append(args, arg)
+	}
+	return args
+}
+
+// looksLikePath reports whether arg names a file or directory rather than a subcommand
+// or target such as "test" or "lint".
+func looksLikePath(arg string) bool {
+	return strings.Contains(arg, "/") || path.Ext(arg) != ""
+}
+
+func isModuleWidePattern(arg string) bool {
+	arg = strings.TrimPrefix(strings.TrimSuffix(arg, "/"), "./")
+	return arg == "..." || arg == "."
+}
+
+// normalizePathPattern strips a leading "./", any glob suffix, and trailing slashes so
+// "./docs/**" and "docs/" both compare as "docs".
+func normalizePathPattern(p string) string {
+	p = strings.TrimPrefix(strings.TrimSpace(p), "./")
+	if i := strings.IndexAny(p, "*?["); i >= 0 {
+		p = p[:i]
+	}
+	return strings.TrimRight(p, "/")
+}
@@ -0,0 +1,138 @@
+package enforcement
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCommandReferencesFile(t *testing.T) {
+	tests := []struct {
+		name    string
+		command string
+		file    string
+		want    bool
+	}{
+		{"exact path", "gofmt -l cmd/app/main.go", "cmd/app/main.go", true},
+		{"package directory", "go test ./pkg/greeter", "pkg/greeter/greeter.go", true},
+		{"recursive package pattern", "go test ./pkg/...", "pkg/greeter/greeter.go", true},
+		{"whole module", "go vet ./...", "pkg/greeter/greeter.go", false},
+		{"current directory", "gofmt -l .", "pkg/greeter/greeter.go", false},
+		{"glob argument", "spelling check on docs/**", "docs/commands.md", true},
+		{"directory scope entry", "./dist/prompt-stack --help", "dist/", true},
+		{"glob scope entry", "markdownlint docs/index.md", "docs/**", true},
+		{"bare file name", "shellcheck build.sh", "scripts/build.sh", true},
+		{"quoted argument", `go test "./pkg/greeter"`, "pkg/greeter/greeter.go", true},
+		{"unrelated package", "go test ./pkg/other", "pkg/greeter/greeter.go", false},
+		{"sibling prefix", "go test ./pkg/greet", "pkg/greeter/greeter.go", false},
+		{"no path arguments", "make test", "pkg/greeter/greeter.go", false},
+		{"flag value is not a path", "go test -run . ./pkg/other", "pkg/greeter/greeter.go", false},
+		{"path after flag values", "go test -run TestGreet -count 1 -v ./pkg/greeter", "pkg/greeter/greeter.go", true},
+		{"inline flag value", "go test -run=greeter.go ./pkg/other", "pkg/greeter/greeter.go", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := commandReferencesFile(tt.command, tt.file); got != tt.want {
+				t.Errorf("commandReferencesFile(%q, %q) = %v, want %v", tt.command, tt.file, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVerificationIsModuleWide(t *testing.T) {
+	tests := []struct {
+		name     string
+		commands []string
+		want     bool
+	}{
+		{"recursive pattern", []string{"go vet ./..."}, true},
+		{"current directory", []string{"make lint", "gofmt -l ."}, true},
+		{"package pattern", []string{"go test ./pkg/..."}, false},
+		{"flag value", []string{"go test -run . ./pkg/greeter"}, false},
+		{"mixed with scoped command", []string{"go test ./pkg/other", "go vet ./..."}, false},
+		{"mixed with bare file", []string{"go vet ./...", "gofmt -l main.go"}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := verificationIsModuleWide(tt.commands); got != tt.want {
+				t.Errorf("verificationIsModuleWide(%q) = %v, want %v", tt.commands, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheckVerificationCoverage(t *testing.T) {
+	config := &RalphyYAML{
+		Tasks: []Task{
+			{
+				ID:           "t1",
+				FilesInScope: []string{"pkg/a/a.go"},
+				Verification: Verification{PreCommit: []string{"go test ./pkg/a", "make lint"}},
+			},
+			{
+				ID:           "t2",
+				FilesInScope: []string{"pkg/b/b.go"},
+				Verification: Verification{PreCommit: []string{"make lint"}, PostCommit: []string{"go test ./pkg/a"}},
+			},
+			{
+				ID:           "t3",
+				FilesInScope: []string{"pkg/c/c.go"},
+				Verification: Verification{Runtime: []string{"go test ./pkg/c", " go test ./pkg/c "}},
+			},
+			{
+				ID:           "t4",
+				FilesInScope: []string{"pkg/d/d.go"},
+			},
+			{
+				ID:           "t5",
+				FilesInScope: []string{"pkg/e/e.go"},
+				Verification: Verification{PreCommit: []string{"go vet ./..."}},
+			},
+			{
+				ID:           "t6",
+				FilesInScope: []string{"pkg/f/f.go"},
+				Verification: Verification{PreCommit: []string{"go test ./pkg/other", "go vet ./..."}},
+			},
+		},
+	}
+
+	result := checkVerificationCoverage(config, ValidationResult{Valid: true})
+	coverage := result.VerificationCoverage
+
+	if coverage.TotalCommands != 8 {
+		t.Errorf("TotalCommands = %d, want 8", coverage.TotalCommands)
+	}
+
+	wantUnique := []string{"go test ./pkg/a", "make lint", "go test ./pkg/c", "go vet ./...", "go test ./pkg/other"}
+	if !reflect.DeepEqual(coverage.UniqueCommands, wantUnique) {
+		t.Errorf("UniqueCommands = %v, want %v", coverage.UniqueCommands, wantUnique)
+	}
+
+	if len(coverage.SharedVerification) != 1 {
+		t.Fatalf("SharedVerification groups = %d, want 1", len(coverage.SharedVerification))
+	}
+	if got := coverage.SharedVerification[0].TaskIDs; !reflect.DeepEqual(got, []string{"t1", "t2"}) {
+		t.Errorf("shared TaskIDs = %v, want [t1 t2]", got)
+	}
+
+	if !reflect.DeepEqual(coverage.TasksWithoutScopeReference, []string{"t2", "t6"}) {
+		t.Errorf("TasksWithoutScopeReference = %v, want [t2 t6]", coverage.TasksWithoutScopeReference)
+	}
+
+	if !reflect.DeepEqual(coverage.TasksModuleWideOnly, []string{"t5"}) {
+		t.Errorf("TasksModuleWideOnly = %v, want [t5]", coverage.TasksModuleWideOnly)
+	}
+
+	if !result.Valid {
+		t.Error("coverage findings should not invalidate the result")
+	}
+
+	types := map[string]int{}
+	for _, v := range result.Violations {
+		types[v.Type]++
+	}
+	if types["shared_verification"] != 1 || types["verification_outside_scope"] != 2 || types["verification_module_wide_only"] != 1 {
+		t.Errorf("violation types = %v, want one shared_verification, two verification_outside_scope and one verification_module_wide_only", types)
+	}
+}
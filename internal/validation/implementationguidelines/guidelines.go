@@ -5,7 +5,7 @@ import (
 	"os"
 	"strings"
 
-	"gopkg.in/yaml.v3"
+	"github.com/kyledavis/prompt-stack/internal/shared"
 )
 
 const (
@@ -76,6 +76,7 @@ type Verification struct {
 
 type ValidationResult struct {
 	Valid                           bool        `json:"valid"`
+	Documents                       int         `json:"documents"`
 	TotalTasks                      int         `json:"total_tasks"`
 	TasksNeedingTests               int         `json:"tasks_needing_tests"`
 	TasksWithTestableCriteria       int         `json:"tasks_with_testable_criteria"`
@@ -86,6 +87,7 @@ type ValidationResult struct {
 }
 
 type Violation struct {
+	Document   int    `json:"document"`
 	TaskID     string `json:"task_id"`
 	TaskTitle  string `json:"task_title"`
 	Issue      string `json:"issue"`
@@ -100,18 +102,68 @@ type Summary struct {
 	OverallScore                   float64 `json:"overall_score"`
 }
 
-func LoadYAML(yamlPath string) (*RalphyYAML, error) {
+// LoadYAML returns one config per YAML document in the file. A file without any
+// documents yields a single empty config.
+func LoadYAML(yamlPath string) ([]*RalphyYAML, error) {
 	yamlBytes, err := os.ReadFile(yamlPath)
 	if err != nil {
 		return nil, fmt.Errorf("failed to read YAML file %q: %w", yamlPath, err)
 	}
 
-	var config RalphyYAML
-	if err := yaml.Unmarshal(yamlBytes, &config); err != nil {
-		return nil, fmt.Errorf("failed to parse YAML: %w", err)
+	documents, err := shared.DecodeYAMLDocuments(yamlBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	configs := []*RalphyYAML{}
+	for index, document := range documents {
+		var config RalphyYAML
+		if err := document.Decode(&config); err != nil {
+			return nil, fmt.Errorf("failed to parse YAML document %d: %w", index+1, err)
+		}
+		configs = append(configs, &config)
+	}
+
+	if len(configs) == 0 {
+		configs = append(configs, &RalphyYAML{})
+	}
+
+	return configs, nil
+}
+
+// validateDocuments validates each document and merges the results, tagging every
+// violation with the number (from 1) of the document it came from.
+func validateDocuments(configs []*RalphyYAML) ValidationResult {
+	merged := ValidationResult{
+		Valid:           true,
+		Documents:       len(configs),
+		Violations:      []Violation{},
+		Recommendations: []string{},
 	}
 
-	return &config, nil
+	for index, config := range configs {
+		result := ValidateImplementationGuidelines(config)
+
+		merged.Valid = merged.Valid && result.Valid
+		merged.TotalTasks += result.TotalTasks
+		merged.TasksNeedingTests += result.TasksNeedingTests
+		merged.TasksWithTestableCriteria += result.TasksWithTestableCriteria
+		merged.TasksWithImplementationGuidance += result.TasksWithImplementationGuidance
+
+		for _, v := range result.Violations {
+			v.Document = index + 1
+			merged.Violations = append(merged.Violations, v)
+		}
+		for _, rec := range result.Recommendations {
+			if len(configs) > 1 {
+				rec = fmt.Sprintf("document %d: %s", index+1, rec)
+			}
+			merged.Recommendations = append(merged.Recommendations, rec)
+		}
+	}
+
+	merged.Summary = summarize(merged)
+	return merged
 }
 
 func ValidateImplementationGuidelines(config *RalphyYAML) ValidationResult {
@@ -164,12 +216,7 @@ func ValidateImplementationGuidelines(config *RalphyYAML) ValidationResult {
 		}
 	}
 
-	if result.TotalTasks > 0 {
-		result.Summary.TestFirstCoverage = float64(result.TasksNeedingTests) / float64(result.TotalTasks) * 100
-		result.Summary.TestableCriteriaCoverage = float64(result.TasksWithTestableCriteria) / float64(result.TotalTasks) * 100
-		result.Summary.ImplementationGuidanceCoverage = float64(result.TasksWithImplementationGuidance) / float64(result.TotalTasks) * 100
-		result.Summary.OverallScore = (result.Summary.TestFirstCoverage*0.4 + result.Summary.TestableCriteriaCoverage*0.3 + result.Summary.ImplementationGuidanceCoverage*0.3) / 100
-	}
+	result.Summary = summarize(result)
 
 	if result.Summary.TestFirstCoverage < 50 {
 		result.Recommendations = append(result.Recommendations,
@@ -194,6 +241,18 @@ func ValidateImplementationGuidelines(config *RalphyYAML) ValidationResult {
 	return result
 }
 
+// summarize computes coverage percentages and the overall score from the task counts.
+func summarize(result ValidationResult) Summary {
+	summary := Summary{}
+	if result.TotalTasks > 0 {
+		summary.TestFirstCoverage = float64(result.TasksNeedingTests) / float64(result.TotalTasks) * 100
+		summary.TestableCriteriaCoverage = float64(result.TasksWithTestableCriteria) / float64(result.TotalTasks) * 100
+		summary.ImplementationGuidanceCoverage = float64(result.TasksWithImplementationGuidance) / float64(result.TotalTasks) * 100
+		summary.OverallScore = (summary.TestFirstCoverage*0.4 + summary.TestableCriteriaCoverage*0.3 + summary.ImplementationGuidanceCoverage*0.3) / 100
+	}
+	return summary
+}
+
 func requiresTestFirstWorkflow(task Task) bool {
 	lowerDesc := strings.ToLower(task.Description)
 	for _, keyword := range testFirstKeywords {
@@ -256,12 +315,12 @@ func hasImplementationGuidance(task Task) bool {
 }
 
 func ValidateImplementationGuidelinesFromFile(yamlPath string) (int, *ValidationResult, error) {
-	config, err := LoadYAML(yamlPath)
+	configs, err := LoadYAML(yamlPath)
 	if err != nil {
 		return ExitExecution, nil, err
 	}
 
-	result := ValidateImplementationGuidelines(config)
+	result := validateDocuments(configs)
 
 	if !result.Valid {
 		return ExitFailed, &result, nil
@@ -0,0 +1,125 @@
+package implementationguidelines
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func writeYAML(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "ralphy.yaml")
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatalf("failed to write YAML: %v", err)
+	}
+	return path
+}
+
+func TestLoadYAML(t *testing.T) {
+	tests := []struct {
+		name      string
+		content   string
+		wantTasks []int
+		wantErr   string
+	}{
+		{
+			name:      "single document",
+			content:   "tasks:\n  - id: t1\n",
+			wantTasks: []int{1},
+		},
+		{
+			name:      "leading and trailing separators",
+			content:   "---\ntasks:\n  - id: t1\n---\n",
+			wantTasks: []int{1},
+		},
+		{
+			name:      "multiple documents",
+			content:   "tasks:\n  - id: t1\n---\ntasks:\n  - id: t2\n  - id: t3\n",
+			wantTasks: []int{1, 2},
+		},
+		{
+			name:      "empty file",
+			content:   "",
+			wantTasks: []int{0},
+		},
+		{
+			name:    "invalid second document",
+			content: "tasks: []\n---\ntasks: [unclosed\n",
+			wantErr: "failed to parse YAML document 2",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			configs, err := LoadYAML(writeYAML(t, tt.content))
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("LoadYAML() error = %v, want containing %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("LoadYAML() error = %v", err)
+			}
+			if len(configs) != len(tt.wantTasks) {
+				t.Fatalf("documents = %d, want %d", len(configs), len(tt.wantTasks))
+			}
+			for i, want := range tt.wantTasks {
+				if got := len(configs[i].Tasks); got != want {
+					t.Errorf("document %d tasks = %d, want %d", i+1, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestValidateImplementationGuidelinesFromFileMultiDocument(t *testing.T) {
+	path := writeYAML(t, `tasks:
+  - id: a1
+    title: Add unit test for greeter
+    acceptance_criteria: ["go test ./pkg/greeter passes"]
+    style_anchors:
+      - file: pkg/greeter/greeter_test.go
+        reason: Table-driven test pattern
+---
+tasks:
+  - id: b1
+    title: Add table-driven test for parser
+    acceptance_criteria: ["Looks good"]
+  - id: b2
+    title: Rename package
+`)
+
+	exitCode, result, err := ValidateImplementationGuidelinesFromFile(path)
+	if err != nil {
+		t.Fatalf("ValidateImplementationGuidelinesFromFile() error = %v", err)
+	}
+	if exitCode != ExitFailed {
+		t.Errorf("exit code = %d, want %d", exitCode, ExitFailed)
+	}
+	if result.Documents != 2 || result.TotalTasks != 3 {
+		t.Errorf("documents/tasks = %d/%d, want 2/3", result.Documents, result.TotalTasks)
+	}
+	if result.TasksNeedingTests != 2 || result.TasksWithTestableCriteria != 1 || result.TasksWithImplementationGuidance != 1 {
+		t.Errorf("needing tests/testable/guidance = %d/%d/%d, want 2/1/1",
+			result.TasksNeedingTests, result.TasksWithTestableCriteria, result.TasksWithImplementationGuidance)
+	}
+	if want := float64(2) / 3 * 100; result.Summary.TestFirstCoverage != want {
+		t.Errorf("TestFirstCoverage = %.2f, want %.2f computed over all documents", result.Summary.TestFirstCoverage, want)
+	}
+
+	if len(result.Violations) != 2 {
+		t.Fatalf("violations = %+v, want 2", result.Violations)
+	}
+	for _, v := range result.Violations {
+		if v.Document != 2 || v.TaskID != "b1" {
+			t.Errorf("violation = %+v, want document 2 task b1", v)
+		}
+	}
+	for _, rec := range result.Recommendations {
+		if !strings.HasPrefix(rec, "document ") {
+			t.Errorf("recommendation %q is not tagged with its document", rec)
+		}
+	}
+}
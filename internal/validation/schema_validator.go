@@ -33,6 +33,7 @@ package validation
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -138,6 +139,35 @@ func validateAgainstSchema(schema *jsonschema.Schema, document interface{}) erro
 	return nil
 }
 
+// SchemaErrors lists the individual schema violations wrapped in err, one entry per
+// failing instance location (e.g., "/tasks/0: missing properties: 'id'"). Errors that
+// are not schema violations are returned as a single entry.
+func SchemaErrors(err error) []string {
+	var verr *jsonschema.ValidationError
+	if !errors.As(err, &verr) {
+		return []string{err.Error()}
+	}
+
+	messages := []string{}
+	var collect func(e *jsonschema.ValidationError)
+	collect = func(e *jsonschema.ValidationError) {
+		if len(e.Causes) == 0 {
+			location := e.InstanceLocation
+			if location == "" {
+				location = "/"
+			}
+			messages = append(messages, fmt.Sprintf("%s: %s", location, e.Message))
+			return
+		}
+		for _, cause := range e.Causes {
+			collect(cause)
+		}
+	}
+	collect(verr)
+
+	return messages
+}
+
 // ValidateYAMLAgainstSchema validates a YAML file against a JSON Schema.
 //
 // Parameters:
@@ -2,7 +2,9 @@ package validation
 
 import (
 	"encoding/json"
+	"fmt"
 	"os"
+	"strings"
 	"testing"
 )
 
@@ -225,6 +227,39 @@ func TestValidateAgainstSchema(t *testing.T) {
 	}
 }
 
+func TestSchemaErrors(t *testing.T) {
+	schema, err := loadAndCompileSchema("../../docs/ralphy-inputs.schema.json")
+	if err != nil {
+		t.Fatalf("Failed to load test schema: %v", err)
+	}
+
+	err = validateAgainstSchema(schema, map[string]interface{}{
+		"name":    "test",
+		"version": "v1.0.0",
+	})
+	if err == nil {
+		t.Fatal("Expected schema validation to fail")
+	}
+
+	messages := SchemaErrors(err)
+	if len(messages) < 2 {
+		t.Fatalf("Expected one message per violation, got %v", messages)
+	}
+	found := false
+	for _, msg := range messages {
+		if strings.HasPrefix(msg, "/version: ") {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("Expected a /version violation, got %v", messages)
+	}
+
+	if got := SchemaErrors(fmt.Errorf("failed to read YAML file")); len(got) != 1 || got[0] != "failed to read YAML file" {
+		t.Errorf("SchemaErrors(plain error) = %v, want the error message", got)
+	}
+}
+
 func TestExitCodes(t *testing.T) {
 	tests := []struct {
 		name string
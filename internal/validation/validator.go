@@ -294,6 +294,9 @@ func (v *TaskSizingValidator) Validate(inputPath string) (ComponentResult, error
 			if v.Title != "" {
 				msg = fmt.Sprintf("%s (%s)", msg, v.Title)
 			}
+			if sizingResult.Documents > 1 {
+				msg = fmt.Sprintf("document %d: %s", v.Document, msg)
+			}
 
 			fix := "Adjust task sizing to match configured bounds"
 			if v.Issue == "duration_below_minimum" || v.Issue == "duration_above_maximum" {
@@ -370,6 +373,9 @@ func (v *ConstraintsValidator) Validate(inputPath string) (ComponentResult, erro
 				if v.ConstraintText != "" {
 					msg = fmt.Sprintf("%s (%s)", msg, v.ConstraintText)
 				}
+				if constraintsResult.Documents > 1 {
+					msg = fmt.Sprintf("document %d: %s", v.Document, msg)
+				}
 				fix := v.Suggestion
 				if fix == "" {
 					fix = "Rephrase constraints to be specific and affirmative"